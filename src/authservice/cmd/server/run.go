@@ -21,6 +21,7 @@ import (
 type Server struct {
 	repo       *serverV1.Repository
 	conf       *config.Config
+	watcher    *config.Watcher
 	runGroup   *run.Group
 	logger     log.Logger
 	grpcServer *grpc.Server
@@ -31,6 +32,7 @@ type Server struct {
 func NewServer(
 	repo *serverV1.Repository,
 	conf *config.Config,
+	watcher *config.Watcher,
 	runGroup *run.Group,
 	logger log.Logger,
 	grpcServer *grpc.Server,
@@ -38,6 +40,7 @@ func NewServer(
 ) *Server {
 	return &Server{
 		conf:       conf,
+		watcher:    watcher,
 		repo:       repo,
 		runGroup:   runGroup,
 		logger:     logger,
@@ -71,6 +74,14 @@ func (s *Server) RunServer() {
 		s.grpcServer.Stop()
 	})
 
+	// 配置热加载。监听配置文件变化以及 SIGHUP 信号
+	ctx, cancel := context.WithCancel(context.Background())
+	s.runGroup.Add(func() error {
+		return s.watcher.Run(ctx)
+	}, func(err error) {
+		cancel()
+	})
+
 	// 监听退出信号
 	s.runGroup.Add(run.SignalHandler(context.Background(), syscall.SIGINT, syscall.SIGTERM))
 
@@ -8,6 +8,8 @@ import (
 	"github.com/google/wire"
 
 	"authservice/config"
+	"authservice/pkg/rbac"
+	"authservice/pkg/session"
 )
 
 func InitServer(cfg string) (*Server, error) {
@@ -15,6 +17,7 @@ func InitServer(cfg string) (*Server, error) {
 	wire.Build(
 		// 配置
 		config.NewConfig,
+		config.NewWatcher,
 
 		// 实例化 grpc 以及 http 服务
 		NewServer,
@@ -25,6 +28,8 @@ func InitServer(cfg string) (*Server, error) {
 
 		// 组件
 		NewRedis,
+		session.NewStore,
+		rbac.NewStore,
 		NewGrpcServer,
 		NewRunGroup,
 		NewLogger,
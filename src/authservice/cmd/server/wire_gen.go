@@ -8,6 +8,8 @@ package server
 
 import (
 	"authservice/config"
+	"authservice/pkg/rbac"
+	"authservice/pkg/session"
 	"authservice/service/v1/server"
 )
 
@@ -16,15 +18,21 @@ import (
 func InitServer(cfg string) (*Server, error) {
 	configConfig := config.NewConfig(cfg)
 	client := NewRedis(configConfig)
+	store := session.NewStore(client)
+	rbacStore := rbac.NewStore(client)
+	logger := NewLogger()
+	watcher := config.NewWatcher(cfg, configConfig, logger)
 	tracerProvider, err := NewTrace(configConfig)
 	if err != nil {
 		return nil, err
 	}
-	repository := serverV1.NewRepository(client, configConfig, tracerProvider)
+	repository, err := serverV1.NewRepository(client, store, rbacStore, watcher, tracerProvider)
+	if err != nil {
+		return nil, err
+	}
 	group := NewRunGroup()
-	logger := NewLogger()
 	authorizationServer := serverV1.NewServer(configConfig, client, repository, logger)
 	server := NewGrpcServer(authorizationServer)
-	serverServer := NewServer(repository, configConfig, group, logger, server, tracerProvider)
+	serverServer := NewServer(repository, configConfig, watcher, group, logger, server, tracerProvider)
 	return serverServer, nil
 }
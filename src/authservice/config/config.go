@@ -11,6 +11,10 @@ type Config struct {
 	Redis     Redis     `json:"redis" yaml:"redis"`
 	WhiteList WhiteList `json:"whiteList" yaml:"whiteList"`
 	Trace     Trace     `json:"trace" yaml:"trace"`
+
+	Verification Verification `json:"verification" yaml:"verification"`
+	Rbac         Rbac         `json:"rbac" yaml:"rbac"`
+	Session      Session      `json:"session" yaml:"session"`
 }
 
 // NewConfig Initial service's config
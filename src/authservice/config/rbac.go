@@ -0,0 +1,20 @@
+package config
+
+// Rbac 角色权限配置
+type Rbac struct {
+	// 是否开启权限校验。关闭时需要同时开启 AllowDisabled，否则启动失败
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// 开发模式。允许关闭权限校验，关闭后登录用户可以访问所有接口，只用于本地开发
+	AllowDisabled bool `json:"allowDisabled" yaml:"allowDisabled"`
+	// 没有匹配到权限规则并且不在权限白名单内的接口是否拒绝访问
+	DefaultDeny bool `json:"defaultDeny" yaml:"defaultDeny"`
+	// 接口需要的权限。按顺序匹配，使用第一条匹配的规则
+	Rules []PermissionRule `json:"rules" yaml:"rules"`
+}
+
+// PermissionRule 接口权限规则
+type PermissionRule struct {
+	Route `json:",inline" yaml:",inline" mapstructure:",squash"`
+	// 需要的权限
+	Permission string `json:"permission" yaml:"permission"`
+}
@@ -0,0 +1,9 @@
+package config
+
+import "time"
+
+// Session 会话配置。空闲超时以及绝对有效期由 userservice 登录时写入会话数据
+type Session struct {
+	// 延长会话空闲过期时间的最小间隔。间隔内的请求不写 redis。只在 session 验证模式下生效
+	TouchInterval time.Duration `json:"touchInterval" yaml:"touchInterval"`
+}
@@ -0,0 +1,31 @@
+package config
+
+import "time"
+
+// access token 验证模式
+const (
+	// VerificationModeSession 通过 redis 会话数据验证
+	VerificationModeSession = "session"
+	// VerificationModeJWT 通过 JWKS 公钥在本地验证签名
+	VerificationModeJWT = "jwt"
+)
+
+// Verification access token 验证配置
+type Verification struct {
+	// 验证模式[session/jwt]。为空时使用 session
+	Mode string `json:"mode" yaml:"mode"`
+	// 本地 JWKS 文件。与 jwksUrl 二选一，优先使用文件
+	JWKSFile string `json:"jwksFile" yaml:"jwksFile"`
+	// JWKS 接口地址
+	JWKSURL string `json:"jwksUrl" yaml:"jwksUrl"`
+	// JWKS 接口刷新间隔
+	JWKSRefreshInterval time.Duration `json:"jwksRefreshInterval" yaml:"jwksRefreshInterval"`
+	// 为空时不校验 iss
+	Issuer string `json:"issuer" yaml:"issuer"`
+	// 为空时不校验 aud
+	Audience []string `json:"audience" yaml:"audience"`
+	// 校验 exp/nbf 时允许的时钟误差
+	Leeway time.Duration `json:"leeway" yaml:"leeway"`
+	// 是否查询 redis 注销列表
+	CheckRevocation bool `json:"checkRevocation" yaml:"checkRevocation"`
+}
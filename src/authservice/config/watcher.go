@@ -0,0 +1,225 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/spf13/viper"
+)
+
+// restartSections 修改后需要重启服务才能生效的配置
+var restartSections = []string{"grpc", "http", "redis", "trace"}
+
+// ReloadHandler 配置变更处理。校验新配置并准备派生数据，所有处理都成功后才调用返回的 commit
+type ReloadHandler func(conf *Config) (commit func(), err error)
+
+// Watcher 配置热加载。监听配置文件变化以及 SIGHUP 信号，新配置校验通过后整体替换
+type Watcher struct {
+	path     string
+	logger   log.Logger
+	current  atomic.Pointer[Config]
+	mu       sync.Mutex
+	settings map[string]string
+	handlers []ReloadHandler
+}
+
+// NewWatcher 实例化 Watcher。conf 为启动时加载的配置
+func NewWatcher(cfg string, conf *Config, logger log.Logger) *Watcher {
+
+	w := &Watcher{path: cfg, logger: logger}
+	w.current.Store(conf)
+	if _, settings, err := read(cfg); err == nil {
+		w.settings = settings
+	}
+	return w
+
+}
+
+// Load 获取当前生效的配置。调用方不能修改返回的配置
+func (w *Watcher) Load() *Config {
+	return w.current.Load()
+}
+
+// OnReload 注册配置变更处理
+func (w *Watcher) OnReload(handler ReloadHandler) {
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.handlers = append(w.handlers, handler)
+
+}
+
+// Reload 重新加载配置文件。新配置读取或者校验失败时继续使用当前配置
+func (w *Watcher) Reload() error {
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	conf, settings, err := read(w.path)
+	if err != nil {
+		_ = level.Error(w.logger).Log("msg", "重新加载配置失败，继续使用当前配置，错误[1]："+err.Error())
+		return err
+	}
+
+	commits := make([]func(), 0, len(w.handlers))
+	for _, handler := range w.handlers {
+		commit, err := handler(conf)
+		if err != nil {
+			_ = level.Error(w.logger).Log("msg", "新配置校验失败，继续使用当前配置，错误[2]："+err.Error())
+			return err
+		}
+		commits = append(commits, commit)
+	}
+
+	changes := diff(w.settings, settings)
+	if len(changes) == 0 {
+		_ = level.Info(w.logger).Log("msg", "配置没有变化")
+		return nil
+	}
+
+	w.current.Store(conf)
+	w.settings = settings
+	for _, commit := range commits {
+		commit()
+	}
+
+	for _, c := range changes {
+		_ = level.Info(w.logger).Log("msg", "配置已更新", "key", c.key, "old", c.old, "new", c.new)
+		if requiresRestart(c.key) {
+			_ = level.Warn(w.logger).Log("msg", "该配置需要重启服务才能生效", "key", c.key)
+		}
+	}
+	return nil
+
+}
+
+// Run 监听配置文件以及 SIGHUP 信号，直到 ctx 结束
+func (w *Watcher) Run(ctx context.Context) error {
+
+	// configmap 通过替换软链接更新，viper 会监听所在目录并处理这种情况
+	v := viper.New()
+	v.SetConfigFile(w.path)
+	if err := v.ReadInConfig(); err != nil {
+		return err
+	}
+	v.OnConfigChange(func(e fsnotify.Event) {
+		_ = level.Info(w.logger).Log("msg", "配置文件发生变化", "file", e.Name)
+		_ = w.Reload()
+	})
+	v.WatchConfig()
+
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-hup:
+			_ = level.Info(w.logger).Log("msg", "收到 SIGHUP 信号，重新加载配置")
+			_ = w.Reload()
+		}
+	}
+
+}
+
+// read 使用独立的 viper 实例读取配置，避免读取失败时影响当前配置
+func read(path string) (*Config, map[string]string, error) {
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, nil, err
+	}
+	conf := &Config{}
+	if err := v.Unmarshal(conf); err != nil {
+		return nil, nil, err
+	}
+	settings := make(map[string]string)
+	flatten("", v.AllSettings(), settings)
+	return conf, settings, nil
+
+}
+
+// flatten 将嵌套的配置展开为 a.b.c 形式
+func flatten(prefix string, values map[string]any, out map[string]string) {
+
+	for k, v := range values {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if m, ok := v.(map[string]any); ok {
+			flatten(key, m, out)
+			continue
+		}
+		out[key] = fmt.Sprint(v)
+	}
+
+}
+
+// change 单个配置项的变化
+type change struct {
+	key string
+	old string
+	new string
+}
+
+// diff 对比新旧配置。敏感配置不输出具体值
+func diff(old map[string]string, new map[string]string) []change {
+
+	var changes []change
+	for k, v := range new {
+		if o, ok := old[k]; !ok || o != v {
+			changes = append(changes, change{key: k, old: old[k], new: v})
+		}
+	}
+	for k, v := range old {
+		if _, ok := new[k]; !ok {
+			changes = append(changes, change{key: k, old: v})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].key < changes[j].key })
+	for i, c := range changes {
+		if isSecret(c.key) {
+			changes[i].old, changes[i].new = mask(c.old), mask(c.new)
+		}
+	}
+	return changes
+
+}
+
+func isSecret(key string) bool {
+	key = strings.ToLower(key)
+	return strings.Contains(key, "password") || strings.Contains(key, "secret")
+}
+
+func mask(value string) string {
+	if value == "" {
+		return ""
+	}
+	return "******"
+}
+
+func requiresRestart(key string) bool {
+
+	section := strings.SplitN(key, ".", 2)[0]
+	for _, s := range restartSections {
+		if section == s {
+			return true
+		}
+	}
+	return false
+
+}
@@ -1,7 +1,37 @@
 package config
 
+import "authservice/pkg/route"
+
 // WhiteList 权限白名单。包含 api 接口白名单以及 rbac 权限白名单。
 type WhiteList struct {
-	Api        []any `json:"api" yaml:"api"`
-	Permission []any `json:"permission" yaml:"permission"`
+	// 不需要登录的接口
+	Api []Route `json:"api" yaml:"api"`
+	// 登录即可访问，不需要校验 rbac 权限的接口
+	Permission []Route `json:"permission" yaml:"permission"`
+}
+
+// Route 路由规则
+type Route struct {
+	// 请求路径或者匹配模式。http 接口路径或者 grpc 方法全名
+	Path string `json:"path" yaml:"path"`
+	// 匹配方式[exact/prefix/glob/regex]。为空时使用 exact
+	Match string `json:"match" yaml:"match"`
+	// 请求方法。为空时匹配所有方法
+	Methods []string `json:"methods" yaml:"methods"`
+}
+
+// Rule 转换为路由匹配规则
+func (r Route) Rule() route.Rule {
+	return route.Rule{Path: r.Path, Match: r.Match, Methods: r.Methods}
+}
+
+// Rules 批量转换为路由匹配规则
+func Rules(routes []Route) []route.Rule {
+
+	rules := make([]route.Rule, 0, len(routes))
+	for _, r := range routes {
+		rules = append(rules, r.Rule())
+	}
+	return rules
+
 }
@@ -0,0 +1,171 @@
+package identity
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// authservice 鉴权通过后注入的身份请求头。客户端传入的同名请求头会被 authservice 覆盖或者移除
+const (
+	HeaderUserID    = "x-user-id"
+	HeaderUsername  = "x-username"
+	HeaderSessionID = "x-session-id"
+	HeaderRoles     = "x-roles"
+)
+
+// Headers 所有身份请求头
+var Headers = []string{HeaderUserID, HeaderUsername, HeaderSessionID, HeaderRoles}
+
+// Principal 调用方身份
+type Principal struct {
+	UserID    int64
+	Username  string
+	SessionID string
+	Roles     []string
+}
+
+// HasRole 是否拥有角色
+func (p *Principal) HasRole(role string) bool {
+
+	for _, v := range p.Roles {
+		if v == role {
+			return true
+		}
+	}
+	return false
+
+}
+
+// Values 转换为请求头数据。顺序与 Headers 一致
+func (p *Principal) Values() []string {
+	return []string{
+		strconv.FormatInt(p.UserID, 10),
+		p.Username,
+		p.SessionID,
+		strings.Join(p.Roles, ","),
+	}
+}
+
+type principalKey struct{}
+
+// NewContext 将身份写入 context
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext 从 context 获取身份。未登录时返回 false
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
+
+// FromMetadata 从 grpc metadata 解析身份。没有 x-user-id 时返回 false
+func FromMetadata(md metadata.MD) (*Principal, bool) {
+
+	first := func(key string) string {
+		if v := md.Get(key); len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+
+	userID, err := strconv.ParseInt(first(HeaderUserID), 10, 64)
+	if err != nil || userID <= 0 {
+		return nil, false
+	}
+	p := &Principal{
+		UserID:    userID,
+		Username:  first(HeaderUsername),
+		SessionID: first(HeaderSessionID),
+	}
+	for _, role := range strings.Split(first(HeaderRoles), ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			p.Roles = append(p.Roles, role)
+		}
+	}
+	return p, true
+
+}
+
+// fromIncoming 将请求 metadata 中的身份写入 context
+func fromIncoming(ctx context.Context) context.Context {
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	if p, ok := FromMetadata(md); ok {
+		return NewContext(ctx, p)
+	}
+	return ctx
+
+}
+
+// UnaryServerInterceptor 将身份请求头加载到 context
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(fromIncoming(ctx), req)
+	}
+}
+
+// StreamServerInterceptor 将身份请求头加载到 context
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, ctx: fromIncoming(ss.Context())})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// toOutgoing 将 context 中的身份写入下游请求 metadata
+func toOutgoing(ctx context.Context) context.Context {
+
+	p, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	kv := make([]string, 0, len(Headers)*2)
+	for i, v := range p.Values() {
+		kv = append(kv, Headers[i], v)
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+
+}
+
+// UnaryClientInterceptor 调用其他服务时传递身份
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(toOutgoing(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor 调用其他服务时传递身份
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(toOutgoing(ctx), desc, cc, method, opts...)
+	}
+}
+
+// HeaderMatcher grpc-gateway 请求头匹配。身份请求头原样转发给 grpc 服务，其他请求头交给 fallback 处理
+func HeaderMatcher(fallback func(key string) (string, bool)) func(key string) (string, bool) {
+	return func(key string) (string, bool) {
+		lower := strings.ToLower(key)
+		for _, h := range Headers {
+			if lower == h {
+				return h, true
+			}
+		}
+		return fallback(key)
+	}
+}
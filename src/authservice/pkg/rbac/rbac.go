@@ -0,0 +1,110 @@
+package rbac
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// rolePermissionsKeyPrefix 角色权限 key 前缀。完整 key 为 rbac:role_permissions:{role}
+	rolePermissionsKeyPrefix = "rbac:role_permissions:"
+	// userRolesKeyPrefix 用户角色 key 前缀。完整 key 为 rbac:user_roles:{userId}
+	userRolesKeyPrefix = "rbac:user_roles:"
+)
+
+const (
+	// PermissionAll 拥有所有权限
+	PermissionAll = "*"
+	// RoleAdmin 内置管理员角色。拥有所有权限
+	RoleAdmin = "admin"
+)
+
+// Store 基于 redis 的角色权限数据。userservice 以 mysql 为准写入，authservice 鉴权时读取
+type Store struct {
+	redis *redis.Client
+}
+
+// NewStore 实例化 Store
+func NewStore(redis *redis.Client) *Store {
+	return &Store{redis: redis}
+}
+
+// RolePermissionsKey 角色权限 key
+func RolePermissionsKey(role string) string {
+	return rolePermissionsKeyPrefix + role
+}
+
+// UserRolesKey 用户角色 key
+func UserRolesKey(userID int64) string {
+	return userRolesKeyPrefix + strconv.FormatInt(userID, 10)
+}
+
+// SetRolePermissions 覆盖角色的权限
+func (s *Store) SetRolePermissions(ctx context.Context, role string, permissions []string) error {
+	_, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, RolePermissionsKey(role))
+		if len(permissions) > 0 {
+			pipe.SAdd(ctx, RolePermissionsKey(role), toAny(permissions)...)
+		}
+		return nil
+	})
+	return err
+}
+
+// DeleteRole 删除角色的权限
+func (s *Store) DeleteRole(ctx context.Context, role string) error {
+	return s.redis.Del(ctx, RolePermissionsKey(role)).Err()
+}
+
+// SetUserRoles 覆盖用户的角色
+func (s *Store) SetUserRoles(ctx context.Context, userID int64, roles []string) error {
+	_, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, UserRolesKey(userID))
+		if len(roles) > 0 {
+			pipe.SAdd(ctx, UserRolesKey(userID), toAny(roles)...)
+		}
+		return nil
+	})
+	return err
+}
+
+// UserRoles 获取用户的角色
+func (s *Store) UserRoles(ctx context.Context, userID int64) ([]string, error) {
+	return s.redis.SMembers(ctx, UserRolesKey(userID)).Result()
+}
+
+// HasPermission 任意一个角色拥有权限即可
+func (s *Store) HasPermission(ctx context.Context, roles []string, permission string) (bool, error) {
+
+	if len(roles) == 0 {
+		return false, nil
+	}
+	cmds, err := s.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, role := range roles {
+			pipe.SMIsMember(ctx, RolePermissionsKey(role), permission, PermissionAll)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, cmd := range cmds {
+		for _, ok := range cmd.(*redis.BoolSliceCmd).Val() {
+			if ok {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+
+}
+
+func toAny(values []string) []any {
+	result := make([]any, 0, len(values))
+	for _, v := range values {
+		result = append(result, v)
+	}
+	return result
+}
@@ -0,0 +1,197 @@
+package route
+
+import (
+	"errors"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// 路径匹配方式
+const (
+	MatchExact  = "exact"
+	MatchPrefix = "prefix"
+	MatchGlob   = "glob"
+	MatchRegex  = "regex"
+)
+
+// Rule 路由规则
+type Rule struct {
+	// 请求路径或者匹配模式
+	Path string
+	// 匹配方式[exact/prefix/glob/regex]。为空时使用 exact。prefix 按照 / 以及 . 分段匹配
+	Match string
+	// 请求方法。为空时匹配所有方法
+	Methods []string
+}
+
+// compiled 编译后的路由规则
+type compiled struct {
+	match   string
+	path    string
+	regexp  *regexp.Regexp
+	methods map[string]struct{}
+}
+
+// Matcher 路由匹配器
+type Matcher struct {
+	rules []compiled
+}
+
+// Compile 编译路由规则。规则不合法时返回错误，用于启动时校验配置
+func Compile(rules []Rule) (*Matcher, error) {
+
+	m := &Matcher{rules: make([]compiled, 0, len(rules))}
+	for i, rule := range rules {
+		c, err := compile(rule)
+		if err != nil {
+			return nil, errors.New("route rule #" + strconv.Itoa(i) + " " + rule.Path + ": " + err.Error())
+		}
+		m.rules = append(m.rules, c)
+	}
+	return m, nil
+
+}
+
+// compile 编译单条路由规则
+func compile(rule Rule) (compiled, error) {
+
+	c := compiled{match: strings.ToLower(rule.Match), path: rule.Path}
+	if c.match == "" {
+		c.match = MatchExact
+	}
+	if rule.Path == "" {
+		return c, errors.New("path can not be empty")
+	}
+
+	switch c.match {
+	case MatchExact, MatchPrefix:
+		if !strings.HasPrefix(rule.Path, "/") {
+			return c, errors.New("path must start with /")
+		}
+	case MatchGlob:
+		if !strings.HasPrefix(rule.Path, "/") {
+			return c, errors.New("path must start with /")
+		}
+		re, err := regexp.Compile(globToRegexp(rule.Path))
+		if err != nil {
+			return c, err
+		}
+		c.regexp = re
+	case MatchRegex:
+		re, err := regexp.Compile("^(?:" + rule.Path + ")$")
+		if err != nil {
+			return c, err
+		}
+		c.regexp = re
+	default:
+		return c, errors.New("unsupported match type " + rule.Match)
+	}
+
+	if len(rule.Methods) > 0 {
+		c.methods = make(map[string]struct{}, len(rule.Methods))
+		for _, method := range rule.Methods {
+			method = strings.ToUpper(strings.TrimSpace(method))
+			if method == "" {
+				return c, errors.New("method can not be empty")
+			}
+			c.methods[method] = struct{}{}
+		}
+	}
+	return c, nil
+
+}
+
+// globToRegexp 将 glob 转换为正则。* 不跨越 /，** 匹配任意字符
+func globToRegexp(glob string) string {
+
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch glob[i] {
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				b.WriteString(".*")
+				i++
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(glob[i])))
+		}
+	}
+	b.WriteString("$")
+	return b.String()
+
+}
+
+// Match 返回第一条匹配的规则下标。path 需要先经过 Normalize 处理
+func (m *Matcher) Match(method string, path string) (int, bool) {
+
+	method = strings.ToUpper(method)
+	for i, rule := range m.rules {
+		if rule.methods != nil {
+			if _, ok := rule.methods[method]; !ok {
+				continue
+			}
+		}
+		if rule.matchPath(path) {
+			return i, true
+		}
+	}
+	return -1, false
+
+}
+
+// matchPath 匹配请求路径
+func (c compiled) matchPath(p string) bool {
+
+	switch c.match {
+	case MatchExact:
+		return p == c.path
+	case MatchPrefix:
+		return matchPrefix(p, c.path)
+	}
+	return c.regexp.MatchString(p)
+
+}
+
+// matchPrefix 按路径分段匹配前缀。前缀之后必须是分隔符 / 或者 .，或者路径到此结束，
+// 避免 /user.v1.admin 匹配到 /user.v1.administrator 这样的相邻接口
+func matchPrefix(p string, prefix string) bool {
+
+	if !strings.HasPrefix(p, prefix) {
+		return false
+	}
+	if len(p) == len(prefix) {
+		return true
+	}
+	// 前缀本身以分隔符结尾时已经在分段边界上
+	if last := prefix[len(prefix)-1]; last == '/' || last == '.' {
+		return true
+	}
+	next := p[len(prefix)]
+	return next == '/' || next == '.'
+
+}
+
+// Normalize 规范化请求路径：去除 query 以及 fragment，解码百分号编码，合并重复的 / 并处理 . 以及 ..
+func Normalize(raw string) (string, error) {
+
+	if i := strings.IndexAny(raw, "?#"); i >= 0 {
+		raw = raw[:i]
+	}
+	decoded, err := url.PathUnescape(raw)
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(decoded, "/") {
+		decoded = "/" + decoded
+	}
+	return path.Clean(decoded), nil
+
+}
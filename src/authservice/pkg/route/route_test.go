@@ -0,0 +1,135 @@
+package route
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+
+	m, err := Compile([]Rule{
+		{Path: "/user.v1.info", Methods: []string{"GET"}},
+		{Path: "/user.v1.admin.", Match: MatchPrefix},
+		{Path: "/user.v1.session", Match: MatchPrefix},
+		{Path: "/order.v1.*/detail", Match: MatchGlob},
+		{Path: "/product/**", Match: MatchGlob, Methods: []string{"post", " put "}},
+		{Path: `/order/[0-9]+`, Match: MatchRegex},
+	})
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		want   int
+		ok     bool
+	}{
+		{"exact", "GET", "/user.v1.info", 0, true},
+		{"exact lower case method", "get", "/user.v1.info", 0, true},
+		{"exact method mismatch", "POST", "/user.v1.info", -1, false},
+		{"exact does not match prefix", "GET", "/user.v1.info/1", -1, false},
+		{"prefix", "DELETE", "/user.v1.admin.users", 1, true},
+		{"prefix itself", "GET", "/user.v1.admin.", 1, true},
+		{"prefix segment boundary dot", "GET", "/user.v1.session.list", 2, true},
+		{"prefix segment boundary slash", "GET", "/user.v1.session/1", 2, true},
+		{"prefix ends at path end", "GET", "/user.v1.session", 2, true},
+		{"prefix does not match sibling", "GET", "/user.v1.sessions", -1, false},
+		{"prefix ending with separator does not match sibling", "GET", "/user.v1.administrator", -1, false},
+		{"glob single segment", "GET", "/order.v1.list/detail", 3, true},
+		{"glob star does not cross slash", "GET", "/order.v1.a/b/detail", -1, false},
+		{"glob double star", "POST", "/product/1/stock", 4, true},
+		{"glob double star trimmed method", "PUT", "/product/1", 4, true},
+		{"glob double star method mismatch", "GET", "/product/1", -1, false},
+		{"regex", "GET", "/order/123", 5, true},
+		{"regex is anchored", "GET", "/order/123/pay", -1, false},
+		{"no match", "GET", "/unknown", -1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := m.Match(tt.method, tt.path)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("Match(%q, %q) = %d, %v, want %d, %v", tt.method, tt.path, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+
+}
+
+func TestMatchFirstRuleWins(t *testing.T) {
+
+	m, err := Compile([]Rule{
+		{Path: "/user.v1.admin.roles", Match: MatchExact},
+		{Path: "/user.v1.admin.**", Match: MatchGlob},
+	})
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	if got, _ := m.Match("GET", "/user.v1.admin.roles"); got != 0 {
+		t.Errorf("Match() = %d, want 0", got)
+	}
+	if got, _ := m.Match("GET", "/user.v1.admin.users"); got != 1 {
+		t.Errorf("Match() = %d, want 1", got)
+	}
+
+}
+
+func TestCompileInvalid(t *testing.T) {
+
+	tests := []struct {
+		name string
+		rule Rule
+	}{
+		{"empty path", Rule{Path: ""}},
+		{"exact without slash", Rule{Path: "user"}},
+		{"prefix without slash", Rule{Path: "user", Match: MatchPrefix}},
+		{"glob without slash", Rule{Path: "user/*", Match: MatchGlob}},
+		{"invalid regex", Rule{Path: "/order/(", Match: MatchRegex}},
+		{"unsupported match", Rule{Path: "/user", Match: "suffix"}},
+		{"empty method", Rule{Path: "/user", Methods: []string{" "}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Compile([]Rule{tt.rule}); err == nil {
+				t.Errorf("Compile(%+v) error = nil, want error", tt.rule)
+			}
+		})
+	}
+
+}
+
+func TestNormalize(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{"clean path", "/user.v1.info", "/user.v1.info", false},
+		{"query string", "/user.v1.info?id=1", "/user.v1.info", false},
+		{"fragment", "/user.v1.info#top", "/user.v1.info", false},
+		{"double slash", "//user.v1.admin.users", "/user.v1.admin.users", false},
+		{"inner double slash", "/product//1", "/product/1", false},
+		{"dot segment", "/./user.v1.admin.users", "/user.v1.admin.users", false},
+		{"dot dot segment", "/user.v1.info/../user.v1.admin.users", "/user.v1.admin.users", false},
+		{"encoded dot dot", "/user.v1.info/%2e%2e/user.v1.admin.users", "/user.v1.admin.users", false},
+		{"encoded upper case dot", "/%2E/user.v1.admin.users", "/user.v1.admin.users", false},
+		{"encoded slash", "/product%2F1", "/product/1", false},
+		{"dot dot above root", "/../../user.v1.admin.users", "/user.v1.admin.users", false},
+		{"trailing slash", "/user.v1.info/", "/user.v1.info", false},
+		{"missing leading slash", "user.v1.info", "/user.v1.info", false},
+		{"empty", "", "/", false},
+		{"invalid escape", "/user%zz", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Normalize(%q) error = %v, wantErr %v", tt.raw, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+
+}
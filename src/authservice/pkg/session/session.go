@@ -0,0 +1,358 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// sessionKeyPrefix 会话数据 key 前缀。完整 key 为 session:{accessToken}
+	sessionKeyPrefix = "session:"
+	// userSessionsKeyPrefix 用户会话索引 key 前缀。完整 key 为 user_sessions:{userId}
+	userSessionsKeyPrefix = "user_sessions:"
+	// revokedKeyPrefix 已注销会话 key 前缀。完整 key 为 revoked_session:{sessionId}
+	revokedKeyPrefix = "revoked_session:"
+)
+
+var (
+	// ErrNotFound 会话不存在或者已经过期
+	ErrNotFound = errors.New("session not found")
+	// ErrExpired 会话已经超过空闲超时或者绝对过期时间
+	ErrExpired = errors.New("session expired")
+)
+
+// Session 登录会话数据。userservice 登录时写入，authservice 鉴权时读取
+type Session struct {
+	// ID 会话 ID。刷新 access token 时保持不变
+	ID        string `json:"id"`
+	Token     string `json:"token"`
+	UserID    int64  `json:"user_id"`
+	Username  string `json:"username"`
+	IssuedAt  int64  `json:"issued_at"`
+	ExpireAt  int64  `json:"expire_at"`
+	ClientIP  string `json:"client_ip"`
+	UserAgent string `json:"user_agent"`
+	// CreatedAt 会话创建时间。刷新 access token 时保持不变
+	CreatedAt int64 `json:"created_at"`
+	// AbsoluteExpireAt 会话绝对过期时间。到期后必须重新登录，为 0 时不限制
+	AbsoluteExpireAt int64 `json:"absolute_expire_at"`
+	// IdleTimeout 空闲超时时间，单位秒。超过该时间没有请求时会话过期，为 0 时不限制
+	IdleTimeout int64 `json:"idle_timeout"`
+	// LastActiveAt 最后一次延长空闲过期时间的时间
+	LastActiveAt int64 `json:"last_active_at"`
+}
+
+// Expired access token 或者会话是否已经过期
+func (s *Session) Expired(now time.Time) bool {
+
+	n := now.Unix()
+	if s.ExpireAt > 0 && n >= s.ExpireAt {
+		return true
+	}
+	if s.AbsoluteExpireAt > 0 && n >= s.AbsoluteExpireAt {
+		return true
+	}
+	return s.IdleTimeout > 0 && s.LastActiveAt > 0 && n >= s.LastActiveAt+s.IdleTimeout
+
+}
+
+// TTL 会话数据在 redis 中的有效期。取空闲超时与绝对过期时间中较早的一个
+func (s *Session) TTL(now time.Time) time.Duration {
+
+	var ttl time.Duration
+	if s.IdleTimeout > 0 {
+		ttl = time.Duration(s.IdleTimeout) * time.Second
+	}
+	if s.AbsoluteExpireAt > 0 {
+		remaining := time.Unix(s.AbsoluteExpireAt, 0).Sub(now)
+		if ttl == 0 || remaining < ttl {
+			ttl = remaining
+		}
+	}
+	return ttl
+
+}
+
+// Store 基于 redis 的会话存储
+type Store struct {
+	redis *redis.Client
+}
+
+// NewStore 实例化 Store
+func NewStore(redis *redis.Client) *Store {
+	return &Store{redis: redis}
+}
+
+// SessionKey 会话数据 key
+func SessionKey(token string) string {
+	return sessionKeyPrefix + token
+}
+
+// UserSessionsKey 用户会话索引 key
+func UserSessionsKey(userID int64) string {
+	return userSessionsKeyPrefix + strconv.FormatInt(userID, 10)
+}
+
+// RevokedKey 已注销会话 key
+func RevokedKey(id string) string {
+	return revokedKeyPrefix + id
+}
+
+// Save 保存会话数据并加入用户会话索引。有效期由会话的空闲超时以及绝对过期时间决定
+func (s *Store) Save(ctx context.Context, sess *Session) error {
+
+	ttl := sess.TTL(time.Now())
+	if ttl <= 0 {
+		return ErrExpired
+	}
+	data, err := json.Marshal(sess)
+	if err != nil {
+		return err
+	}
+	current, err := s.redis.TTL(ctx, UserSessionsKey(sess.UserID)).Result()
+	if err != nil {
+		return err
+	}
+	_, err = s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, SessionKey(sess.Token), data, ttl)
+		pipe.SAdd(ctx, UserSessionsKey(sess.UserID), sess.Token)
+		extendIndex(ctx, pipe, sess, ttl, current)
+		return nil
+	})
+	return err
+
+}
+
+// Rotate 使用新的 access token 替换旧会话数据
+func (s *Store) Rotate(ctx context.Context, old *Session, sess *Session) error {
+
+	ttl := sess.TTL(time.Now())
+	if ttl <= 0 {
+		return ErrExpired
+	}
+	data, err := json.Marshal(sess)
+	if err != nil {
+		return err
+	}
+	current, err := s.redis.TTL(ctx, UserSessionsKey(sess.UserID)).Result()
+	if err != nil {
+		return err
+	}
+	_, err = s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, SessionKey(old.Token))
+		pipe.SRem(ctx, UserSessionsKey(old.UserID), old.Token)
+		pipe.Set(ctx, SessionKey(sess.Token), data, ttl)
+		pipe.SAdd(ctx, UserSessionsKey(sess.UserID), sess.Token)
+		extendIndex(ctx, pipe, sess, ttl, current)
+		return nil
+	})
+	return err
+
+}
+
+// indexTTL 会话需要的用户会话索引有效期。有绝对过期时间时保留到绝对过期时间，
+// 空闲超时延长后会话仍然可以在索引中找到
+func indexTTL(sess *Session, ttl time.Duration) time.Duration {
+
+	if sess.AbsoluteExpireAt > 0 {
+		return time.Until(time.Unix(sess.AbsoluteExpireAt, 0))
+	}
+	return ttl
+
+}
+
+// extendIndex 延长用户会话索引的有效期。索引中可能还有更晚过期的其他会话，current 为索引当前的剩余有效期，
+// 只在会话需要更长的有效期时延长，不会缩短
+func extendIndex(ctx context.Context, pipe redis.Pipeliner, sess *Session, ttl time.Duration, current time.Duration) {
+
+	if want := indexTTL(sess, ttl); want > current {
+		pipe.Expire(ctx, UserSessionsKey(sess.UserID), want)
+	}
+
+}
+
+// Get 获取会话数据
+func (s *Store) Get(ctx context.Context, token string) (*Session, error) {
+
+	data, err := s.redis.Get(ctx, SessionKey(token)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	sess := &Session{}
+	if err = json.Unmarshal(data, sess); err != nil {
+		return nil, err
+	}
+	return sess, nil
+
+}
+
+// Touch 延长会话的空闲过期时间。距离上次延长不足 interval 时不写 redis
+func (s *Store) Touch(ctx context.Context, sess *Session, now time.Time, interval time.Duration) error {
+
+	if sess.IdleTimeout <= 0 || now.Sub(time.Unix(sess.LastActiveAt, 0)) < interval {
+		return nil
+	}
+	sess.LastActiveAt = now.Unix()
+	ttl := sess.TTL(now)
+	if ttl <= 0 {
+		return ErrExpired
+	}
+	data, err := json.Marshal(sess)
+	if err != nil {
+		return err
+	}
+	// 只更新仍然存在的会话，避免与 Rotate 并发时恢复已经替换的 access token
+	ok, err := s.redis.SetXX(ctx, SessionKey(sess.Token), data, ttl).Result()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotFound
+	}
+	current, err := s.redis.TTL(ctx, UserSessionsKey(sess.UserID)).Result()
+	if err != nil {
+		return err
+	}
+	_, err = s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SAdd(ctx, UserSessionsKey(sess.UserID), sess.Token)
+		extendIndex(ctx, pipe, sess, ttl, current)
+		return nil
+	})
+	return err
+
+}
+
+// Delete 删除单个会话
+func (s *Store) Delete(ctx context.Context, token string) error {
+
+	sess, err := s.Get(ctx, token)
+	if err != nil {
+		return err
+	}
+	_, err = s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, SessionKey(token))
+		pipe.SRem(ctx, UserSessionsKey(sess.UserID), token)
+		s.revoke(ctx, pipe, sess)
+		return nil
+	})
+	return err
+
+}
+
+// revoke 将会话加入注销列表，直到最后签发的 access token 过期。
+// 本地验证 JWT 时 access token 在过期前仍然有效，需要通过注销列表拒绝
+func (s *Store) revoke(ctx context.Context, pipe redis.Pipeliner, sess *Session) {
+
+	ttl := time.Until(time.Unix(sess.ExpireAt, 0))
+	if sess.ID == "" || ttl <= 0 {
+		return
+	}
+	pipe.Set(ctx, RevokedKey(sess.ID), 1, ttl)
+
+}
+
+// IsRevoked 会话是否已经被注销
+func (s *Store) IsRevoked(ctx context.Context, id string) (bool, error) {
+
+	n, err := s.redis.Exists(ctx, RevokedKey(id)).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+
+}
+
+// ListByUser 获取用户所有有效会话。顺带清理索引中已经过期的会话
+func (s *Store) ListByUser(ctx context.Context, userID int64) ([]*Session, error) {
+
+	tokens, err := s.redis.SMembers(ctx, UserSessionsKey(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		keys = append(keys, SessionKey(token))
+	}
+	values, err := s.redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var sessions []*Session
+	var expired []any
+	for i, v := range values {
+		data, ok := v.(string)
+		if !ok {
+			expired = append(expired, tokens[i])
+			continue
+		}
+		sess := &Session{}
+		if err = json.Unmarshal([]byte(data), sess); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, sess)
+	}
+	if len(expired) > 0 {
+		if err = s.redis.SRem(ctx, UserSessionsKey(userID), expired...).Err(); err != nil {
+			return nil, err
+		}
+	}
+	return sessions, nil
+
+}
+
+// DeleteByID 根据会话 ID 删除用户的会话
+func (s *Store) DeleteByID(ctx context.Context, userID int64, id string) error {
+
+	sessions, err := s.ListByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, sess := range sessions {
+		if sess.ID != id {
+			continue
+		}
+		if err = s.Delete(ctx, sess.Token); err != nil && !errors.Is(err, ErrNotFound) {
+			return err
+		}
+	}
+	return nil
+
+}
+
+// RevokeUser 注销用户所有会话。返回被注销的会话数量
+func (s *Store) RevokeUser(ctx context.Context, userID int64) (int64, error) {
+
+	sessions, err := s.ListByUser(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if len(sessions) == 0 {
+		return 0, nil
+	}
+	_, err = s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, sess := range sessions {
+			pipe.Del(ctx, SessionKey(sess.Token))
+			s.revoke(ctx, pipe, sess)
+		}
+		pipe.Del(ctx, UserSessionsKey(userID))
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(sessions)), nil
+
+}
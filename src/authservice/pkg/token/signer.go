@@ -0,0 +1,149 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"strconv"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// 支持的签名算法
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// Claims access token 数据
+type Claims struct {
+	jwt.RegisteredClaims
+	// SessionID 登录会话 ID。刷新 token 时保持不变
+	SessionID string `json:"sid"`
+	Username  string `json:"username"`
+	// Roles 用户角色
+	Roles []string `json:"roles,omitempty"`
+}
+
+// UserID 从 sub 字段获取用户 ID
+func (c *Claims) UserID() (int64, error) {
+	return strconv.ParseInt(c.Subject, 10, 64)
+}
+
+// Signer access token 签名器
+type Signer struct {
+	method jwt.SigningMethod
+	key    crypto.Signer
+	kid    string
+	jwks   *JWKS
+}
+
+// NewSigner 实例化 Signer
+// extraKeys 为额外发布到 JWKS 的公钥，用于密钥轮换期间验证旧 token
+func NewSigner(algorithm string, kid string, key crypto.Signer, extraKeys map[string]crypto.PublicKey) (*Signer, error) {
+
+	var method jwt.SigningMethod
+	switch algorithm {
+	case AlgorithmRS256:
+		if _, ok := key.(*rsa.PrivateKey); !ok {
+			return nil, errors.New("RS256 requires an RSA private key")
+		}
+		method = jwt.SigningMethodRS256
+	case AlgorithmEdDSA:
+		if _, ok := key.(ed25519.PrivateKey); !ok {
+			return nil, errors.New("EdDSA requires an Ed25519 private key")
+		}
+		method = jwt.SigningMethodEdDSA
+	default:
+		return nil, errors.New("unsupported signing algorithm " + algorithm)
+	}
+	if kid == "" {
+		return nil, errors.New("key id can not be empty")
+	}
+
+	jwks := &JWKS{}
+	jwk, err := NewJWK(key.Public(), kid)
+	if err != nil {
+		return nil, err
+	}
+	jwks.Keys = append(jwks.Keys, jwk)
+	for extraKid, pub := range extraKeys {
+		if extraKid == kid {
+			return nil, errors.New("duplicate key id " + kid)
+		}
+		jwk, err = NewJWK(pub, extraKid)
+		if err != nil {
+			return nil, err
+		}
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+
+	return &Signer{
+		method: method,
+		key:    key,
+		kid:    kid,
+		jwks:   jwks,
+	}, nil
+
+}
+
+// Sign 签发 access token
+func (s *Signer) Sign(claims *Claims) (string, error) {
+
+	t := jwt.NewWithClaims(s.method, claims)
+	t.Header["kid"] = s.kid
+	return t.SignedString(s.key)
+
+}
+
+// JWKS 获取公钥集合
+func (s *Signer) JWKS() *JWKS {
+	return s.jwks
+}
+
+// GenerateKey 随机生成私钥。只用于本地开发
+func GenerateKey(algorithm string) (crypto.Signer, error) {
+
+	switch algorithm {
+	case AlgorithmRS256:
+		return rsa.GenerateKey(rand.Reader, 2048)
+	case AlgorithmEdDSA:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	}
+	return nil, errors.New("unsupported signing algorithm " + algorithm)
+
+}
+
+// ParsePrivateKey 解析 PEM 格式私钥。支持 PKCS#8 以及 PKCS#1
+func ParsePrivateKey(data []byte) (crypto.Signer, error) {
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid PEM private key")
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, errors.New("unsupported private key type")
+		}
+		return signer, nil
+	}
+	return x509.ParsePKCS1PrivateKey(block.Bytes)
+
+}
+
+// ParsePublicKey 解析 PEM 格式公钥
+func ParsePublicKey(data []byte) (crypto.PublicKey, error) {
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid PEM public key")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+
+}
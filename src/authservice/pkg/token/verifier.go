@@ -0,0 +1,207 @@
+package token
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// KeySource 公钥来源
+type KeySource interface {
+	// JWKS 获取公钥集合。refresh 为 true 时表示遇到未知 kid，需要重新加载
+	JWKS(ctx context.Context, refresh bool) (*JWKS, error)
+}
+
+// StaticKeySource 固定的公钥集合。一般从本地文件加载
+type StaticKeySource struct {
+	jwks *JWKS
+}
+
+// NewFileKeySource 从 JWKS 文件加载公钥
+func NewFileKeySource(path string) (*StaticKeySource, error) {
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	jwks, err := ParseJWKS(data)
+	if err != nil {
+		return nil, err
+	}
+	return &StaticKeySource{jwks: jwks}, nil
+
+}
+
+// JWKS 获取公钥集合
+func (s *StaticKeySource) JWKS(_ context.Context, _ bool) (*JWKS, error) {
+	return s.jwks, nil
+}
+
+// RemoteKeySource 通过 URL 获取公钥集合。定时刷新并且在遇到未知 kid 时提前刷新
+type RemoteKeySource struct {
+	url      string
+	interval time.Duration
+	client   *http.Client
+
+	mu        sync.Mutex
+	jwks      *JWKS
+	fetchedAt time.Time
+}
+
+// minRefreshInterval 遇到未知 kid 时两次刷新的最小间隔，防止伪造 kid 打满 JWKS 接口
+const minRefreshInterval = 30 * time.Second
+
+// NewRemoteKeySource 实例化 RemoteKeySource
+func NewRemoteKeySource(url string, interval time.Duration) *RemoteKeySource {
+	return &RemoteKeySource{
+		url:      url,
+		interval: interval,
+		client:   &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// JWKS 获取公钥集合
+func (s *RemoteKeySource) JWKS(ctx context.Context, refresh bool) (*JWKS, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	age := time.Since(s.fetchedAt)
+	if s.jwks != nil && age < s.interval && (!refresh || age < minRefreshInterval) {
+		return s.jwks, nil
+	}
+
+	jwks, err := s.fetch(ctx)
+	if err != nil {
+		// 刷新失败时继续使用旧的公钥
+		if s.jwks != nil {
+			return s.jwks, nil
+		}
+		return nil, err
+	}
+	s.jwks = jwks
+	s.fetchedAt = time.Now()
+	return jwks, nil
+
+}
+
+// fetch 请求 JWKS 接口
+func (s *RemoteKeySource) fetch(ctx context.Context) (*JWKS, error) {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("fetch jwks failed with status " + resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	return ParseJWKS(data)
+
+}
+
+// Verifier access token 验证器
+type Verifier struct {
+	keys     KeySource
+	issuer   string
+	audience []string
+	leeway   time.Duration
+}
+
+// NewVerifier 实例化 Verifier
+// issuer 为空时不校验 iss，audience 为空时不校验 aud
+func NewVerifier(keys KeySource, issuer string, audience []string, leeway time.Duration) *Verifier {
+	return &Verifier{
+		keys:     keys,
+		issuer:   issuer,
+		audience: audience,
+		leeway:   leeway,
+	}
+}
+
+// Verify 验证签名以及 exp/nbf/iss/aud
+func (v *Verifier) Verify(ctx context.Context, tokenString string) (*Claims, error) {
+
+	claims := &Claims{}
+	parser := jwt.NewParser(
+		jwt.WithValidMethods([]string{AlgorithmRS256, AlgorithmEdDSA}),
+		jwt.WithoutClaimsValidation(),
+	)
+	if _, err := parser.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (any, error) {
+		return v.publicKey(ctx, t)
+	}); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if !claims.VerifyExpiresAt(now.Add(-v.leeway), true) {
+		return nil, errors.New("token is expired")
+	}
+	if !claims.VerifyNotBefore(now.Add(v.leeway), false) {
+		return nil, errors.New("token is not valid yet")
+	}
+	if v.issuer != "" && !claims.VerifyIssuer(v.issuer, true) {
+		return nil, errors.New("token issuer mismatch")
+	}
+	if len(v.audience) > 0 && !v.verifyAudience(claims) {
+		return nil, errors.New("token audience mismatch")
+	}
+	return claims, nil
+
+}
+
+// publicKey 根据 token 头部的 kid 查找公钥
+func (v *Verifier) publicKey(ctx context.Context, t *jwt.Token) (crypto.PublicKey, error) {
+
+	kid, _ := t.Header["kid"].(string)
+	if kid == "" {
+		return nil, errors.New("token header missing kid")
+	}
+
+	jwks, err := v.keys.JWKS(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	jwk, ok := jwks.Lookup(kid)
+	if !ok {
+		// 可能是新轮换的密钥
+		if jwks, err = v.keys.JWKS(ctx, true); err != nil {
+			return nil, err
+		}
+		if jwk, ok = jwks.Lookup(kid); !ok {
+			return nil, errors.New("unknown key id " + kid)
+		}
+	}
+	if jwk.Alg != "" && jwk.Alg != t.Method.Alg() {
+		return nil, errors.New("token algorithm does not match key")
+	}
+	return jwk.PublicKey()
+
+}
+
+// verifyAudience 任意一个 aud 匹配即可
+func (v *Verifier) verifyAudience(claims *Claims) bool {
+
+	for _, aud := range v.audience {
+		if claims.VerifyAudience(aud, true) {
+			return true
+		}
+	}
+	return false
+
+}
@@ -0,0 +1,213 @@
+package token
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	testIssuer   = "userservice"
+	testAudience = "rgrpc"
+)
+
+// testSigner 生成测试用签名器
+func testSigner(t *testing.T, algorithm string, kid string) (*Signer, crypto.Signer) {
+
+	t.Helper()
+	key, err := GenerateKey(algorithm)
+	if err != nil {
+		t.Fatalf("GenerateKey(%s) error = %v", algorithm, err)
+	}
+	signer, err := NewSigner(algorithm, kid, key, nil)
+	if err != nil {
+		t.Fatalf("NewSigner(%s) error = %v", algorithm, err)
+	}
+	return signer, key
+
+}
+
+// testClaims 生成有效的 token 数据
+func testClaims(now time.Time) *Claims {
+	return &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    testIssuer,
+			Subject:   "1",
+			Audience:  jwt.ClaimStrings{testAudience},
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+		SessionID: "sid",
+		Username:  "tester",
+	}
+}
+
+func TestVerify(t *testing.T) {
+
+	now := time.Now()
+	edSigner, edKey := testSigner(t, AlgorithmEdDSA, "ed-key")
+	rsSigner, _ := testSigner(t, AlgorithmRS256, "rs-key")
+	otherSigner, _ := testSigner(t, AlgorithmEdDSA, "ed-key")
+	jwks := &JWKS{Keys: append(append([]JWK{}, edSigner.JWKS().Keys...), rsSigner.JWKS().Keys...)}
+	verifier := NewVerifier(&StaticKeySource{jwks: jwks}, testIssuer, []string{testAudience}, 5*time.Second)
+
+	sign := func(signer *Signer, mutate func(*Claims)) string {
+		claims := testClaims(now)
+		if mutate != nil {
+			mutate(claims)
+		}
+		s, err := signer.Sign(claims)
+		if err != nil {
+			t.Fatalf("Sign() error = %v", err)
+		}
+		return s
+	}
+	// signWith 使用指定算法以及 kid 直接签名，用于构造不合法的头部
+	signWith := func(method jwt.SigningMethod, kid string, key any) string {
+		tok := jwt.NewWithClaims(method, testClaims(now))
+		if kid != "" {
+			tok.Header["kid"] = kid
+		}
+		s, err := tok.SignedString(key)
+		if err != nil {
+			t.Fatalf("SignedString() error = %v", err)
+		}
+		return s
+	}
+	edPublic := edKey.Public().(ed25519.PublicKey)
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr string
+	}{
+		{"EdDSA", sign(edSigner, nil), ""},
+		{"RS256", sign(rsSigner, nil), ""},
+		{"expired within leeway", sign(edSigner, func(c *Claims) {
+			c.ExpiresAt = jwt.NewNumericDate(now.Add(-2 * time.Second))
+		}), ""},
+		{"expired", sign(edSigner, func(c *Claims) {
+			c.ExpiresAt = jwt.NewNumericDate(now.Add(-time.Minute))
+		}), "token is expired"},
+		{"missing exp", sign(edSigner, func(c *Claims) {
+			c.ExpiresAt = nil
+		}), "token is expired"},
+		{"not valid yet", sign(edSigner, func(c *Claims) {
+			c.NotBefore = jwt.NewNumericDate(now.Add(time.Minute))
+		}), "token is not valid yet"},
+		{"issuer mismatch", sign(edSigner, func(c *Claims) {
+			c.Issuer = "other"
+		}), "token issuer mismatch"},
+		{"audience mismatch", sign(edSigner, func(c *Claims) {
+			c.Audience = jwt.ClaimStrings{"other"}
+		}), "token audience mismatch"},
+		{"missing kid", signWith(jwt.SigningMethodEdDSA, "", edKey), "token header missing kid"},
+		{"unknown kid", signWith(jwt.SigningMethodEdDSA, "unknown", edKey), "unknown key id unknown"},
+		{"algorithm does not match key", signWith(jwt.SigningMethodEdDSA, "rs-key", edKey), "token algorithm does not match key"},
+		{"alg none", signWith(jwt.SigningMethodNone, "ed-key", jwt.UnsafeAllowNoneSignatureType), "signing method none is invalid"},
+		{"HS256 with public key", signWith(jwt.SigningMethodHS256, "ed-key", []byte(edPublic)), "signing method HS256 is invalid"},
+		{"signed by another key", sign(otherSigner, nil), "verification error"},
+		{"tampered signature", tamper(sign(edSigner, nil)), "verification error"},
+		{"malformed", "not-a-token", "token contains an invalid number of segments"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := verifier.Verify(context.Background(), tt.token)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Verify() error = %v", err)
+				}
+				if claims.Username != "tester" || claims.SessionID != "sid" {
+					t.Errorf("Verify() claims = %+v", claims)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+}
+
+// tamper 修改签名的第一个字符
+func tamper(token string) string {
+
+	i := strings.LastIndex(token, ".") + 1
+	c := byte('A')
+	if token[i] == 'A' {
+		c = 'B'
+	}
+	return token[:i] + string(c) + token[i+1:]
+
+}
+
+// rotatingKeySource 第一次返回旧的公钥集合，刷新后返回新的公钥集合
+type rotatingKeySource struct {
+	old, current *JWKS
+	refreshed    int
+}
+
+// JWKS 获取公钥集合
+func (s *rotatingKeySource) JWKS(_ context.Context, refresh bool) (*JWKS, error) {
+
+	if refresh {
+		s.refreshed++
+		return s.current, nil
+	}
+	return s.old, nil
+
+}
+
+func TestVerifyRefreshesUnknownKid(t *testing.T) {
+
+	oldSigner, _ := testSigner(t, AlgorithmEdDSA, "key-1")
+	newSigner, _ := testSigner(t, AlgorithmEdDSA, "key-2")
+	keys := &rotatingKeySource{old: oldSigner.JWKS(), current: newSigner.JWKS()}
+	verifier := NewVerifier(keys, "", nil, 0)
+
+	token, err := newSigner.Sign(testClaims(time.Now()))
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	if _, err = verifier.Verify(context.Background(), token); err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if keys.refreshed != 1 {
+		t.Errorf("refreshed = %d, want 1", keys.refreshed)
+	}
+
+}
+
+func TestNewSigner(t *testing.T) {
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	tests := []struct {
+		name      string
+		algorithm string
+		kid       string
+		extra     map[string]crypto.PublicKey
+	}{
+		{"RS256 with Ed25519 key", AlgorithmRS256, "kid", nil},
+		{"unsupported algorithm", "HS256", "kid", nil},
+		{"empty kid", AlgorithmEdDSA, "", nil},
+		{"duplicate kid", AlgorithmEdDSA, "kid", map[string]crypto.PublicKey{"kid": edKey.Public()}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewSigner(tt.algorithm, tt.kid, edKey, tt.extra); err == nil {
+				t.Errorf("NewSigner() error = nil, want error")
+			}
+		})
+	}
+
+}
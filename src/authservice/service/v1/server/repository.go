@@ -3,71 +3,319 @@ package serverV1
 import (
 	"context"
 	"errors"
+	"reflect"
+	"strconv"
+	"sync/atomic"
 
 	"github.com/redis/go-redis/v9"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"time"
 
 	"authservice/config"
+	"authservice/pkg/identity"
+	"authservice/pkg/rbac"
+	"authservice/pkg/route"
+	"authservice/pkg/session"
+	"authservice/pkg/token"
 )
 
 // Repository Repository
 type Repository struct {
-	redis *redis.Client
-	conf  *config.Config
-	trace *sdktrace.TracerProvider
+	redis    *redis.Client
+	sessions *session.Store
+	rbac     *rbac.Store
+	watcher  *config.Watcher
+	state    atomic.Pointer[state]
+	trace    *sdktrace.TracerProvider
+}
+
+// state 由配置派生的运行时数据。配置热加载时整体替换
+type state struct {
+	conf     *config.Config
+	routes   *RouteTable
+	verifier *token.Verifier
 }
 
 // NewRepository New Repository
 func NewRepository(
 	redis *redis.Client,
-	conf *config.Config,
+	sessions *session.Store,
+	rbac *rbac.Store,
+	watcher *config.Watcher,
 	trace *sdktrace.TracerProvider,
-) *Repository {
-	return &Repository{
-		redis: redis,
-		conf:  conf,
-		trace: trace,
+) (*Repository, error) {
+
+	r := &Repository{
+		redis:    redis,
+		sessions: sessions,
+		rbac:     rbac,
+		watcher:  watcher,
+		trace:    trace,
+	}
+	st, err := newState(watcher.Load(), nil)
+	if err != nil {
+		return nil, err
+	}
+	r.state.Store(st)
+
+	// 配置热加载。新配置编译失败时继续使用当前配置
+	watcher.OnReload(func(conf *config.Config) (func(), error) {
+		st, err := newState(conf, r.state.Load())
+		if err != nil {
+			return nil, err
+		}
+		return func() { r.state.Store(st) }, nil
+	})
+	return r, nil
+
+}
+
+// newState 根据配置编译路由规则以及实例化 token 验证器
+// 验证配置没有变化时沿用之前的验证器，保留已经缓存的 JWKS
+func newState(conf *config.Config, prev *state) (*state, error) {
+
+	if !conf.Rbac.Enabled && !conf.Rbac.AllowDisabled {
+		return nil, errors.New("rbac: permission check is disabled without rbac.allowDisabled")
+	}
+	routes, err := NewRouteTable(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	var verifier *token.Verifier
+	if prev != nil && reflect.DeepEqual(prev.conf.Verification, conf.Verification) {
+		verifier = prev.verifier
+	} else if verifier, err = NewTokenVerifier(conf.Verification); err != nil {
+		return nil, errors.New("verification: " + err.Error())
+	}
+
+	return &state{
+		conf:     conf,
+		routes:   routes,
+		verifier: verifier,
+	}, nil
+
+}
+
+// NewTokenVerifier 实例化 access token 验证器。session 模式下不需要
+func NewTokenVerifier(v config.Verification) (*token.Verifier, error) {
+
+	switch v.Mode {
+	case "", config.VerificationModeSession:
+		return nil, nil
+	case config.VerificationModeJWT:
+	default:
+		return nil, errors.New("unsupported verification mode " + v.Mode)
+	}
+
+	var keys token.KeySource
+	switch {
+	case v.JWKSFile != "":
+		source, err := token.NewFileKeySource(v.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		keys = source
+	case v.JWKSURL != "":
+		interval := v.JWKSRefreshInterval
+		if interval <= 0 {
+			interval = 10 * time.Minute
+		}
+		keys = token.NewRemoteKeySource(v.JWKSURL, interval)
+	default:
+		return nil, errors.New("jwt verification requires jwksFile or jwksUrl")
 	}
+
+	return token.NewVerifier(keys, v.Issuer, v.Audience, v.Leeway), nil
+
 }
 
-// GetAuthentication 获取授权数据
-func (r *Repository) GetAuthentication(ctx context.Context, accessToken string, duration int64) error {
+// Authenticate 根据配置的验证模式验证 access token
+func (r *Repository) Authenticate(ctx context.Context, accessToken string) (*identity.Principal, error) {
 
-	exists := r.redis.Exists(ctx, accessToken)
-	if exists.Err() != nil {
-		return exists.Err()
+	st := r.state.Load()
+	if st.conf.Verification.Mode == config.VerificationModeJWT {
+		claims, err := r.VerifyToken(ctx, st, accessToken)
+		if err != nil {
+			return nil, err
+		}
+		userID, err := claims.UserID()
+		if err != nil {
+			return nil, errors.New("access token sub 格式错误")
+		}
+		return &identity.Principal{
+			UserID:    userID,
+			Username:  claims.Username,
+			SessionID: claims.SessionID,
+			Roles:     claims.Roles,
+		}, nil
 	}
-	if exists.Val() <= 0 {
-		return errors.New("access token不存在")
+
+	sess, err := r.GetAuthentication(ctx, st, accessToken)
+	if err != nil {
+		return nil, err
 	}
+	roles, err := r.rbac.UserRoles(ctx, sess.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &identity.Principal{
+		UserID:    sess.UserID,
+		Username:  sess.Username,
+		SessionID: sess.ID,
+		Roles:     roles,
+	}, nil
+
+}
 
-	return r.RefreshAccessTokenExpireTime(accessToken, duration)
+// VerifyToken 在本地验证 access token 签名，并按配置查询注销列表
+func (r *Repository) VerifyToken(ctx context.Context, st *state, accessToken string) (*token.Claims, error) {
+
+	claims, err := st.verifier.Verify(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	if !st.conf.Verification.CheckRevocation {
+		return claims, nil
+	}
+	revoked, err := r.sessions.IsRevoked(ctx, claims.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, errors.New("access token已注销")
+	}
+	return claims, nil
 
 }
 
-// RefreshAccessTokenExpireTime 刷新授权数据过期时间
-func (r *Repository) RefreshAccessTokenExpireTime(accessToken string, duration int64) error {
+// GetAuthentication 获取授权数据。会话数据由 userservice 登录时写入
+func (r *Repository) GetAuthentication(ctx context.Context, st *state, accessToken string) (*session.Session, error) {
 
-	expire := r.redis.Expire(context.Background(), accessToken, time.Second*time.Duration(duration))
-	if expire.Err() != nil {
-		return expire.Err()
+	sess, err := r.sessions.Get(ctx, accessToken)
+	if errors.Is(err, session.ErrNotFound) {
+		return nil, errors.New("access token不存在")
+	}
+	if err != nil {
+		return nil, err
 	}
-	if !expire.Val() {
+	now := time.Now()
+	if sess.Expired(now) {
+		return nil, errors.New("access token已过期")
+	}
+
+	if err = r.RefreshAccessTokenExpireTime(ctx, st, sess, now); err != nil {
+		return nil, err
+	}
+	return sess, nil
+
+}
+
+// RefreshAccessTokenExpireTime 延长会话的空闲过期时间。不会超过会话的绝对过期时间，
+// 距离上次延长不足 session.touchInterval 时不写 redis
+func (r *Repository) RefreshAccessTokenExpireTime(ctx context.Context, st *state, sess *session.Session, now time.Time) error {
+
+	if err := r.sessions.Touch(ctx, sess, now, st.conf.Session.TouchInterval); err != nil {
 		return errors.New("刷新授权缓存数据失败")
 	}
 	return nil
 
 }
 
-// IsWhiteListApi 判断请求的接口是否在接口白名单内
-func (r *Repository) IsWhiteListApi(api any) bool {
+// Authorize 校验用户是否拥有接口需要的权限。path 需要先经过 route.Normalize 处理
+// 权限以 redis 中的用户角色为准，角色变更后立即生效。同时更新 principal 的角色
+func (r *Repository) Authorize(ctx context.Context, principal *identity.Principal, path string, method string) (bool, error) {
+
+	st := r.state.Load()
+	if !st.conf.Rbac.Enabled {
+		return true, nil
+	}
 
-	for _, v := range r.conf.WhiteList.Api {
-		if api == v {
-			return true
+	// 不需要权限的接口也会把角色转发给下游服务，不能使用 token 中可能已经过期的角色。
+	// session 模式下 Authenticate 已经从 redis 加载了角色，不需要重复加载
+	if st.conf.Verification.Mode == config.VerificationModeJWT {
+		roles, err := r.rbac.UserRoles(ctx, principal.UserID)
+		if err != nil {
+			return false, err
 		}
+		principal.Roles = roles
+	}
+
+	permission, ok := st.routes.RequiredPermission(method, path)
+	if !ok {
+		return st.routes.IsWhiteListPermission(method, path) || !st.conf.Rbac.DefaultDeny, nil
+	}
+	return r.rbac.HasPermission(ctx, principal.Roles, permission)
+
+}
+
+// IsWhiteListApi 判断请求的接口是否在接口白名单内。path 需要先经过 route.Normalize 处理
+func (r *Repository) IsWhiteListApi(method string, path string) bool {
+	return r.state.Load().routes.IsWhiteListApi(method, path)
+}
+
+// RouteTable 编译后的白名单以及权限规则
+type RouteTable struct {
+	whiteListApi        *route.Matcher
+	whiteListPermission *route.Matcher
+	rbacRules           *route.Matcher
+	permissions         []string
+}
+
+// NewRouteTable 编译配置中的路由规则。规则不合法时启动失败
+func NewRouteTable(conf *config.Config) (*RouteTable, error) {
+
+	whiteListApi, err := route.Compile(config.Rules(conf.WhiteList.Api))
+	if err != nil {
+		return nil, errors.New("whiteList.api: " + err.Error())
+	}
+	whiteListPermission, err := route.Compile(config.Rules(conf.WhiteList.Permission))
+	if err != nil {
+		return nil, errors.New("whiteList.permission: " + err.Error())
+	}
+
+	rules := make([]route.Rule, 0, len(conf.Rbac.Rules))
+	permissions := make([]string, 0, len(conf.Rbac.Rules))
+	for i, v := range conf.Rbac.Rules {
+		if v.Permission == "" {
+			return nil, errors.New("rbac.rules: route rule #" + strconv.Itoa(i) + " " + v.Path + ": permission can not be empty")
+		}
+		rules = append(rules, v.Rule())
+		permissions = append(permissions, v.Permission)
+	}
+	rbacRules, err := route.Compile(rules)
+	if err != nil {
+		return nil, errors.New("rbac.rules: " + err.Error())
+	}
+
+	return &RouteTable{
+		whiteListApi:        whiteListApi,
+		whiteListPermission: whiteListPermission,
+		rbacRules:           rbacRules,
+		permissions:         permissions,
+	}, nil
+
+}
+
+// IsWhiteListApi 判断请求的接口是否在接口白名单内
+func (t *RouteTable) IsWhiteListApi(method string, path string) bool {
+	_, ok := t.whiteListApi.Match(method, path)
+	return ok
+}
+
+// IsWhiteListPermission 判断请求的接口是否在权限白名单内
+func (t *RouteTable) IsWhiteListPermission(method string, path string) bool {
+	_, ok := t.whiteListPermission.Match(method, path)
+	return ok
+}
+
+// RequiredPermission 获取接口需要的权限
+func (t *RouteTable) RequiredPermission(method string, path string) (string, bool) {
+
+	i, ok := t.rbacRules.Match(method, path)
+	if !ok {
+		return "", false
 	}
-	return false
+	return t.permissions[i], true
 
 }
@@ -2,6 +2,8 @@ package serverV1
 
 import (
 	"encoding/json"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
 	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/go-kit/log"
@@ -9,8 +11,11 @@ import (
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"authservice/config"
+	"authservice/pkg/identity"
+	"authservice/pkg/route"
 	"golang.org/x/net/context"
 )
 
@@ -53,18 +58,24 @@ func (s *Server) Check(ctx context.Context, req *authv3.CheckRequest) (*authv3.C
 	attrs := req.GetAttributes()
 	httpHeaders := attrs.GetRequest().GetHttp().GetHeaders()
 	// 获取请求路径
-	path, exists := httpHeaders[":path"]
+	rawPath, exists := httpHeaders[":path"]
 	if !exists {
 		_ = level.Info(s.logger).Log("msg", "获取不到 :path 字段")
 		return s.Unauthorized(), nil
 	}
+	// 规范化请求路径。去除 query string 等，防止绕过白名单以及权限规则
+	path, err := route.Normalize(rawPath)
+	if err != nil {
+		_ = level.Info(s.logger).Log("msg", ":path 格式错误，错误[1]："+err.Error())
+		return s.Unauthorized(), nil
+	}
+	method := httpHeaders[":method"]
 	// 判断是否是白名单
-	if s.repo.IsWhiteListApi(path) {
-		return s.Allow(), nil
+	if s.repo.IsWhiteListApi(method, path) {
+		return s.AllowAnonymous(), nil
 	}
 	// 获取头部 token
 	token, exists := httpHeaders["authorization"]
-	duration := 7 * 24 * 60 * 60
 	if !exists {
 		_ = level.Info(s.logger).Log("msg", "未传递头部 authorization 字段")
 		return s.Unauthorized(), nil
@@ -78,19 +89,59 @@ func (s *Server) Check(ctx context.Context, req *authv3.CheckRequest) (*authv3.C
 	token = token[7:]
 
 	// 验证 token
-	if err := s.repo.GetAuthentication(ctx, token, int64(duration)); err != nil {
-		_ = level.Info(s.logger).Log("msg", "access token 不存在")
+	principal, err := s.repo.Authenticate(ctx, token)
+	if err != nil {
+		_ = level.Info(s.logger).Log("msg", "access token 验证失败，错误[1]："+err.Error())
 		return s.Unauthorized(), nil
 	}
-	return s.Allow(), nil
+
+	// 验证权限
+	allowed, err := s.repo.Authorize(ctx, principal, path, method)
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "权限校验失败，错误[1]："+err.Error())
+		return s.Forbidden(), nil
+	}
+	if !allowed {
+		_ = level.Info(s.logger).Log("msg", "没有访问权限", "user_id", principal.UserID, "path", path)
+		return s.Forbidden(), nil
+	}
+	return s.Allow(IdentityHeaders(principal)...), nil
+}
+
+// IdentityHeaders 将用户身份转换为请求头。覆盖客户端传入的同名请求头，防止伪造
+func IdentityHeaders(principal *identity.Principal) []*corev3.HeaderValueOption {
+
+	headers := make([]*corev3.HeaderValueOption, 0, len(identity.Headers))
+	for i, value := range principal.Values() {
+		headers = append(headers, &corev3.HeaderValueOption{
+			Header: &corev3.HeaderValue{Key: identity.Headers[i], Value: value},
+			Append: wrapperspb.Bool(false),
+		})
+	}
+	return headers
+
 }
 
 // Allow 通过鉴权。返回 200
-func (s *Server) Allow() *authv3.CheckResponse {
+func (s *Server) Allow(headers ...*corev3.HeaderValueOption) *authv3.CheckResponse {
+	return &authv3.CheckResponse{
+		Status: &status.Status{Code: int32(codes.OK)},
+		HttpResponse: &authv3.CheckResponse_OkResponse{
+			OkResponse: &authv3.OkHttpResponse{
+				Headers: headers,
+			},
+		},
+	}
+}
+
+// AllowAnonymous 白名单接口通过鉴权。移除客户端传入的身份请求头，防止伪造
+func (s *Server) AllowAnonymous() *authv3.CheckResponse {
 	return &authv3.CheckResponse{
 		Status: &status.Status{Code: int32(codes.OK)},
 		HttpResponse: &authv3.CheckResponse_OkResponse{
-			OkResponse: &authv3.OkHttpResponse{},
+			OkResponse: &authv3.OkHttpResponse{
+				HeadersToRemove: identity.Headers,
+			},
 		},
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"os"
 	"syscall"
 
+	"github.com/dtm-labs/dtmcli"
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -19,9 +20,11 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"gorm.io/gorm"
 
+	"authservice/pkg/identity"
 	"orderservice/config"
 	orderPBV1 "orderservice/genproto/go/v1"
 	"orderservice/service/model"
+	serverV1 "orderservice/service/v1/server"
 )
 
 // Server 启动微服务所需要的所有依赖
@@ -32,6 +35,7 @@ type Server struct {
 	httpServer *http.Server
 	grpcServer *grpc.Server
 	mysqlDB    *gorm.DB
+	sweeper    *serverV1.OrderSweeper
 	trace      *sdktrace.TracerProvider
 }
 
@@ -43,6 +47,7 @@ func NewServer(
 	httpServer *http.Server,
 	grpcServer *grpc.Server,
 	mysqlDB *gorm.DB,
+	sweeper *serverV1.OrderSweeper,
 	trace *sdktrace.TracerProvider,
 ) *Server {
 	return &Server{
@@ -52,6 +57,7 @@ func NewServer(
 		httpServer: httpServer,
 		grpcServer: grpcServer,
 		mysqlDB:    mysqlDB,
+		sweeper:    sweeper,
 		trace:      trace,
 	}
 }
@@ -63,10 +69,14 @@ func NewGrpcServer(orderServerV1 orderPBV1.OrderServiceServer) *grpc.Server {
 		grpc.ChainStreamInterceptor(
 			// otel 链路追踪
 			otelgrpc.StreamServerInterceptor(),
+			// 加载 authservice 注入的调用方身份
+			identity.StreamServerInterceptor(),
 		),
 		grpc.ChainUnaryInterceptor(
 			// otel 链路追踪
 			otelgrpc.UnaryServerInterceptor(),
+			// 加载 authservice 注入的调用方身份
+			identity.UnaryServerInterceptor(),
 			// PGV 中间件
 			Jgrpc_pgv_interceptor.ValidationUnaryInterceptor,
 		),
@@ -83,6 +93,7 @@ func NewHttpServer(conf *config.Config) *http.Server {
 		runtime.WithErrorHandler(Jgrpc_response.HttpErrorHandler),
 		runtime.WithForwardResponseOption(Jgrpc_response.HttpSuccessResponseModifier),
 		runtime.WithMarshalerOption("*", &Jgrpc_response.CustomMarshaller{}),
+		runtime.WithIncomingHeaderMatcher(identity.HeaderMatcher(runtime.DefaultHeaderMatcher)),
 	)
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -131,6 +142,17 @@ func (s *Server) RunServer() {
 			_ = level.Error(s.logger).Log("msg", "failed to stop web server", "err", err)
 		}
 	})
+
+	// 启动超时订单取消任务
+	sweepCtx, sweepCancel := context.WithCancel(context.Background())
+	s.runGroup.Add(func() error {
+
+		_ = level.Info(s.logger).Log("msg", "starting order sweeper", "interval", s.conf.Payment.SweepInterval)
+		return s.sweeper.Run(sweepCtx)
+
+	}, func(err error) {
+		sweepCancel()
+	})
 	s.runGroup.Add(run.SignalHandler(context.Background(), syscall.SIGINT, syscall.SIGTERM))
 
 	if err := s.runGroup.Run(); err != nil {
@@ -150,6 +172,8 @@ func Run(cfg string) {
 
 	// 执行 migrate
 	model.Migrate(server.mysqlDB)
+	// dtm 二阶段消息回查使用全局的子事务屏障表名称
+	dtmcli.SetBarrierTableName((&model.Barrier{}).TableName())
 
 	// 上报 trace 数据
 	defer func() {
@@ -7,6 +7,7 @@ import (
 	"github.com/google/wire"
 	Jgrpc_otelspan "github.com/janrs-io/Jgrpc-otel-span"
 
+	"authservice/pkg/rbac"
 	"orderservice/config"
 	clientV1 "orderservice/service/v1/client"
 	serverV1 "orderservice/service/v1/server"
@@ -24,12 +25,15 @@ func InitServer(cfg string) (*Server, error) {
 		// 实例化服务
 		serverV1.NewServer,
 		serverV1.NewRepository,
+		serverV1.NewOrderSweeper,
 
 		// 实例化客户端
 		clientV1.NewProductClient,
 		clientV1.NewOrderClient,
 
 		// 组件
+		NewRedis,
+		rbac.NewStore,
 		NewMysqlDB,
 		NewHttpServer,
 		NewGrpcServer,
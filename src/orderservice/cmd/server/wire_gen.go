@@ -7,6 +7,7 @@
 package server
 
 import (
+	"authservice/pkg/rbac"
 	"github.com/janrs-io/Jgrpc-otel-span"
 	"orderservice/config"
 	"orderservice/service/v1/client"
@@ -26,17 +27,20 @@ func InitServer(cfg string) (*Server, error) {
 		return nil, err
 	}
 	otelSpan := Jgrpc_otelspan.New(tracerProvider)
-	repository := serverV1.NewRepository(db, configConfig, otelSpan)
-	orderServiceClient, err := clientV1.NewOrderClient(configConfig)
+	client := NewRedis(configConfig)
+	store := rbac.NewStore(client)
+	productServiceClient, err := clientV1.NewProductClient(configConfig)
 	if err != nil {
 		return nil, err
 	}
-	productServiceClient, err := clientV1.NewProductClient(configConfig)
+	repository := serverV1.NewRepository(db, configConfig, otelSpan, store, client, productServiceClient)
+	orderServiceClient, err := clientV1.NewOrderClient(configConfig)
 	if err != nil {
 		return nil, err
 	}
 	orderServiceServer := serverV1.NewServer(logger, configConfig, repository, orderServiceClient, productServiceClient)
 	grpcServer := NewGrpcServer(orderServiceServer)
-	serverServer := NewServer(configConfig, group, logger, server, grpcServer, db, tracerProvider)
+	orderSweeper := serverV1.NewOrderSweeper(configConfig, logger, repository)
+	serverServer := NewServer(configConfig, group, logger, server, grpcServer, db, orderSweeper, tracerProvider)
 	return serverServer, nil
 }
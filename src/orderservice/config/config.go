@@ -6,11 +6,16 @@ import (
 
 // Config Service config
 type Config struct {
-	Grpc     Grpc     `json:"grpc" yaml:"grpc"`
-	Http     Http     `json:"http" yaml:"http"`
-	Database Database `json:"database" yaml:"database"`
-	Client   Client   `json:"client" yaml:"client"`
-	Trace    Trace    `json:"trace" yaml:"trace"`
+	Grpc        Grpc        `json:"grpc" yaml:"grpc"`
+	Http        Http        `json:"http" yaml:"http"`
+	Database    Database    `json:"database" yaml:"database"`
+	Redis       Redis       `json:"redis" yaml:"redis"`
+	Client      Client      `json:"client" yaml:"client"`
+	Trace       Trace       `json:"trace" yaml:"trace"`
+	Rbac        Rbac        `json:"rbac" yaml:"rbac"`
+	Saga        Saga        `json:"saga" yaml:"saga"`
+	Idempotency Idempotency `json:"idempotency" yaml:"idempotency"`
+	Payment     Payment     `json:"payment" yaml:"payment"`
 }
 
 // NewConfig Initial service's config
@@ -21,6 +26,7 @@ func NewConfig(cfg string) *Config {
 	}
 
 	viper.SetConfigFile(cfg)
+	setPaymentDefaults()
 
 	// Read config file
 	if err := viper.ReadInConfig(); err != nil {
@@ -31,6 +37,9 @@ func NewConfig(cfg string) *Config {
 	if err := viper.Unmarshal(conf); err != nil {
 		panic("assign config failed.[ERROR]=>" + err.Error())
 	}
+	if err := conf.Payment.validate(); err != nil {
+		panic("invalid config.[ERROR]=>" + err.Error())
+	}
 
 	return conf
 
@@ -0,0 +1,11 @@
+package config
+
+import "time"
+
+// Idempotency 下单幂等键配置
+type Idempotency struct {
+	// 处理成功的结果保存时间。有效期内使用相同幂等键的请求直接返回首次请求的结果
+	TTL time.Duration `json:"ttl" yaml:"ttl"`
+	// 处理中记录的有效期。需要大于 saga 全局事务超时时间，服务异常退出后到期释放幂等键
+	PendingTTL time.Duration `json:"pendingTTL" yaml:"pendingTTL"`
+}
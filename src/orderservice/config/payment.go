@@ -0,0 +1,41 @@
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Payment 订单支付配置
+type Payment struct {
+	// 下单后等待支付的时间。超时未支付的订单不能再支付，由后台任务取消并释放预留库存。
+	// 需要小于产品服务的 reservation.ttl，保证支付时预留库存还没有过期
+	Timeout time.Duration `json:"timeout" yaml:"timeout"`
+	// 后台任务扫描超时订单的间隔
+	SweepInterval time.Duration `json:"sweepInterval" yaml:"sweepInterval"`
+	// 后台任务每次最多取消的订单数量
+	SweepBatch int `json:"sweepBatch" yaml:"sweepBatch"`
+}
+
+// setPaymentDefaults 订单支付配置默认值
+func setPaymentDefaults() {
+	viper.SetDefault("payment.timeout", 15*time.Minute)
+	viper.SetDefault("payment.sweepInterval", time.Minute)
+	viper.SetDefault("payment.sweepBatch", 100)
+}
+
+// validate 检查订单支付配置
+func (p Payment) validate() error {
+
+	switch {
+	case p.Timeout <= 0:
+		return errors.New("payment.timeout must be greater than 0")
+	case p.SweepInterval <= 0:
+		return errors.New("payment.sweepInterval must be greater than 0")
+	case p.SweepBatch <= 0:
+		return errors.New("payment.sweepBatch must be greater than 0")
+	}
+	return nil
+
+}
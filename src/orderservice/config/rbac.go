@@ -0,0 +1,7 @@
+package config
+
+// Rbac 角色权限配置
+type Rbac struct {
+	// 查看以及管理所有用户订单需要的权限。没有该权限时只能访问自己的订单
+	AdminPermission string `json:"adminPermission" yaml:"adminPermission"`
+}
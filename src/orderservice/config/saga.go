@@ -0,0 +1,20 @@
+package config
+
+import "time"
+
+// Saga dtm saga 分布式事务配置
+type Saga struct {
+	// dtm 服务地址
+	DtmServer string `json:"dtmServer" yaml:"dtmServer"`
+	// dtm 调用各个分支服务的地址。分支接口路径由生成的 grpc 代码决定
+	ProductTarget string `json:"productTarget" yaml:"productTarget"`
+	OrderTarget   string `json:"orderTarget" yaml:"orderTarget"`
+	// 全局事务超时时间。超时后执行补偿
+	TimeoutToFail time.Duration `json:"timeoutToFail" yaml:"timeoutToFail"`
+	// dtm 调用分支接口的超时时间
+	RequestTimeout time.Duration `json:"requestTimeout" yaml:"requestTimeout"`
+	// 分支临时失败后的重试间隔
+	RetryInterval time.Duration `json:"retryInterval" yaml:"retryInterval"`
+	// 是否等待事务执行完成后再返回
+	WaitResult bool `json:"waitResult" yaml:"waitResult"`
+}
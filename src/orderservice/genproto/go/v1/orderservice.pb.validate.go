@@ -70,10 +70,10 @@ func (m *CreateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPayTime() < 1 {
+	if m.GetUserId() < 0 {
 		err := CreateRequestValidationError{
-			field:  "PayTime",
-			reason: "value must be greater than or equal to 1",
+			field:  "UserId",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -81,10 +81,10 @@ func (m *CreateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetUserId() < 1 {
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 64 {
 		err := CreateRequestValidationError{
-			field:  "UserId",
-			reason: "value must be greater than or equal to 1",
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 64 runes",
 		}
 		if !all {
 			return err
@@ -92,10 +92,10 @@ func (m *CreateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetProductId() < 1 {
+	if !_CreateRequest_IdempotencyKey_Pattern.MatchString(m.GetIdempotencyKey()) {
 		err := CreateRequestValidationError{
-			field:  "ProductId",
-			reason: "value must be greater than or equal to 1",
+			field:  "IdempotencyKey",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]*$\"",
 		}
 		if !all {
 			return err
@@ -103,10 +103,10 @@ func (m *CreateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if val := m.GetOrderStatus(); val < 1 || val > 2 {
+	if l := len(m.GetItems()); l < 1 || l > 50 {
 		err := CreateRequestValidationError{
-			field:  "OrderStatus",
-			reason: "value must be inside range [1, 2]",
+			field:  "Items",
+			reason: "value must contain between 1 and 50 items, inclusive",
 		}
 		if !all {
 			return err
@@ -114,7 +114,39 @@ func (m *CreateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Amount
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
 		return CreateRequestMultiError(errors)
@@ -194,6 +226,233 @@ var _ interface {
 	ErrorName() string
 } = CreateRequestValidationError{}
 
+var _CreateRequest_IdempotencyKey_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]*$")
+
+// Validate checks the field values on CreateItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreateItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreateItemMultiError, or
+// nil if none found.
+func (m *CreateItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetProductId() < 1 {
+		err := CreateItemValidationError{
+			field:  "ProductId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() < 1 {
+		err := CreateItemValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for UnitPrice
+
+	if len(errors) > 0 {
+		return CreateItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateItemMultiError is an error wrapping multiple validation errors
+// returned by CreateItem.ValidateAll() if the designated constraints aren't met.
+type CreateItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateItemMultiError) AllErrors() []error { return m }
+
+// CreateItemValidationError is the validation error returned by
+// CreateItem.Validate if the designated constraints aren't met.
+type CreateItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateItemValidationError) ErrorName() string { return "CreateItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateItemValidationError{}
+
+// Validate checks the field values on CreateResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreateResponseMultiError,
+// or nil if none found.
+func (m *CreateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderNo
+
+	if len(errors) > 0 {
+		return CreateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateResponseMultiError) AllErrors() []error { return m }
+
+// CreateResponseValidationError is the validation error returned by
+// CreateResponse.Validate if the designated constraints aren't met.
+type CreateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateResponseValidationError) ErrorName() string { return "CreateResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateResponseValidationError{}
+
 // Validate checks the field values on UpdateRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -227,6 +486,39 @@ func (m *UpdateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if _, ok := _UpdateRequest_OrderStatus_InLookup[m.GetOrderStatus()]; !ok {
+		err := UpdateRequestValidationError{
+			field:  "OrderStatus",
+			reason: "value must be in list [3 4 5 6]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() < 1 {
+		err := UpdateRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRemark()) > 255 {
+		err := UpdateRequestValidationError{
+			field:  "Remark",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return UpdateRequestMultiError(errors)
 	}
@@ -305,6 +597,13 @@ var _ interface {
 	ErrorName() string
 } = UpdateRequestValidationError{}
 
+var _UpdateRequest_OrderStatus_InLookup = map[int64]struct{}{
+	3: {},
+	4: {},
+	5: {},
+	6: {},
+}
+
 // Validate checks the field values on DeleteRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -549,11 +848,115 @@ func (m *ListRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Page
+	if m.GetPage() < 0 {
+		err := ListRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for PageSize
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCursor() < 0 {
+		err := ListRequestValidationError{
+			field:  "Cursor",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Name
+	if m.GetOrderStatus() < 0 {
+		err := ListRequestValidationError{
+			field:  "OrderStatus",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPayStatus() < 0 {
+		err := ListRequestValidationError{
+			field:  "PayStatus",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPaymentType() < 0 {
+		err := ListRequestValidationError{
+			field:  "PaymentType",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetProductId() < 0 {
+		err := ListRequestValidationError{
+			field:  "ProductId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartTime() < 0 {
+		err := ListRequestValidationError{
+			field:  "StartTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndTime() < 0 {
+		err := ListRequestValidationError{
+			field:  "EndTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() < 0 {
+		err := ListRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return ListRequestMultiError(errors)
@@ -690,6 +1093,8 @@ func (m *ListResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextCursor
+
 	if len(errors) > 0 {
 		return ListResponseMultiError(errors)
 	}
@@ -901,8 +1306,6 @@ func (m *OrderDetail) validate(all bool) error {
 
 	// no validation rules for UserId
 
-	// no validation rules for ProductId
-
 	// no validation rules for OrderStatus
 
 	// no validation rules for Amount
@@ -911,6 +1314,44 @@ func (m *OrderDetail) validate(all bool) error {
 
 	// no validation rules for UpdateTime
 
+	// no validation rules for PayStatus
+
+	// no validation rules for Version
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderDetailValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderDetailValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderDetailValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return OrderDetailMultiError(errors)
 	}
@@ -988,6 +1429,116 @@ var _ interface {
 	ErrorName() string
 } = OrderDetailValidationError{}
 
+// Validate checks the field values on OrderItemDetail with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderItemDetail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderItemDetail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderItemDetailMultiError, or nil if none found.
+func (m *OrderItemDetail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderItemDetail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ProductId
+
+	// no validation rules for Quantity
+
+	// no validation rules for UnitPrice
+
+	// no validation rules for Subtotal
+
+	if len(errors) > 0 {
+		return OrderItemDetailMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderItemDetailMultiError is an error wrapping multiple validation errors
+// returned by OrderItemDetail.ValidateAll() if the designated constraints
+// aren't met.
+type OrderItemDetailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderItemDetailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderItemDetailMultiError) AllErrors() []error { return m }
+
+// OrderItemDetailValidationError is the validation error returned by
+// OrderItemDetail.Validate if the designated constraints aren't met.
+type OrderItemDetailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderItemDetailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderItemDetailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderItemDetailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderItemDetailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderItemDetailValidationError) ErrorName() string { return "OrderItemDetailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderItemDetailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderItemDetail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderItemDetailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderItemDetailValidationError{}
+
 // Validate checks the field values on UserDetail with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,13 +20,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	OrderService_Create_FullMethodName       = "/proto.order.v1.OrderService/Create"
-	OrderService_CreateRevert_FullMethodName = "/proto.order.v1.OrderService/CreateRevert"
-	OrderService_Update_FullMethodName       = "/proto.order.v1.OrderService/Update"
-	OrderService_Detail_FullMethodName       = "/proto.order.v1.OrderService/Detail"
-	OrderService_Delete_FullMethodName       = "/proto.order.v1.OrderService/Delete"
-	OrderService_List_FullMethodName         = "/proto.order.v1.OrderService/List"
-	OrderService_CreateSaga_FullMethodName   = "/proto.order.v1.OrderService/CreateSaga"
+	OrderService_Create_FullMethodName        = "/proto.order.v1.OrderService/Create"
+	OrderService_CreateRevert_FullMethodName  = "/proto.order.v1.OrderService/CreateRevert"
+	OrderService_Update_FullMethodName        = "/proto.order.v1.OrderService/Update"
+	OrderService_Detail_FullMethodName        = "/proto.order.v1.OrderService/Detail"
+	OrderService_Delete_FullMethodName        = "/proto.order.v1.OrderService/Delete"
+	OrderService_List_FullMethodName          = "/proto.order.v1.OrderService/List"
+	OrderService_CreateSaga_FullMethodName    = "/proto.order.v1.OrderService/CreateSaga"
+	OrderService_QueryPrepared_FullMethodName = "/proto.order.v1.OrderService/QueryPrepared"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -40,6 +42,7 @@ type OrderServiceClient interface {
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*Response, error)
 	// saga 事务接口
 	CreateSaga(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*Response, error)
+	QueryPrepared(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Response, error)
 }
 
 type orderServiceClient struct {
@@ -113,6 +116,15 @@ func (c *orderServiceClient) CreateSaga(ctx context.Context, in *CreateRequest,
 	return out, nil
 }
 
+func (c *orderServiceClient) QueryPrepared(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, OrderService_QueryPrepared_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility
@@ -125,6 +137,7 @@ type OrderServiceServer interface {
 	List(context.Context, *ListRequest) (*Response, error)
 	// saga 事务接口
 	CreateSaga(context.Context, *CreateRequest) (*Response, error)
+	QueryPrepared(context.Context, *emptypb.Empty) (*Response, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -153,6 +166,9 @@ func (UnimplementedOrderServiceServer) List(context.Context, *ListRequest) (*Res
 func (UnimplementedOrderServiceServer) CreateSaga(context.Context, *CreateRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSaga not implemented")
 }
+func (UnimplementedOrderServiceServer) QueryPrepared(context.Context, *emptypb.Empty) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPrepared not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 
 // UnsafeOrderServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -292,6 +308,24 @@ func _OrderService_CreateSaga_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_QueryPrepared_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).QueryPrepared(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_QueryPrepared_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).QueryPrepared(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -327,6 +361,10 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSaga",
 			Handler:    _OrderService_CreateSaga_Handler,
 		},
+		{
+			MethodName: "QueryPrepared",
+			Handler:    _OrderService_QueryPrepared_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/orderservice.proto",
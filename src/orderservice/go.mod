@@ -7,6 +7,8 @@ replace authservice => ../authservice
 replace productservice => ../productservice
 
 require (
+	authservice v0.0.0
+	github.com/dtm-labs/dtmcli v1.15.0
 	github.com/dtm-labs/dtmgrpc v1.15.0
 	github.com/envoyproxy/protoc-gen-validate v1.0.1
 	github.com/go-kit/log v0.2.1
@@ -17,12 +19,14 @@ require (
 	github.com/janrs-io/Jgrpc-pgv-interceptor v0.0.1
 	github.com/janrs-io/Jgrpc-response v0.0.2
 	github.com/oklog/run v1.1.0
+	github.com/redis/go-redis/v9 v9.0.4
 	github.com/spf13/viper v1.15.0
 	go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc v0.36.0
 	go.opentelemetry.io/otel v1.16.0
 	go.opentelemetry.io/otel/exporters/otlp/otlptrace v1.16.0
 	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc v1.16.0
 	go.opentelemetry.io/otel/sdk v1.16.0
+	google.golang.org/genproto v0.0.0-20230410155749-daa745c078e1
 	google.golang.org/grpc v1.55.0
 	google.golang.org/protobuf v1.30.0
 	gorm.io/driver/mysql v1.5.0
@@ -34,7 +38,6 @@ require (
 	github.com/cenkalti/backoff/v4 v4.2.1 // indirect
 	github.com/cespare/xxhash/v2 v2.2.0 // indirect
 	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
-	github.com/dtm-labs/dtmcli v1.15.0 // indirect
 	github.com/dtm-labs/dtmdriver v0.0.3 // indirect
 	github.com/fsnotify/fsnotify v1.6.0 // indirect
 	github.com/go-logfmt/logfmt v0.5.1 // indirect
@@ -77,7 +80,6 @@ require (
 	golang.org/x/sync v0.1.0 // indirect
 	golang.org/x/sys v0.8.0 // indirect
 	golang.org/x/text v0.9.0 // indirect
-	google.golang.org/genproto v0.0.0-20230410155749-daa745c078e1 // indirect
 	gopkg.in/ini.v1 v1.67.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"gorm.io/gorm"
+)
+
+// MigrateOrderItemTable 迁移 order_item 表
+func MigrateOrderItemTable(db *gorm.DB) {
+
+	m := db.Migrator()
+	if !m.HasTable(&OrderItem{}) {
+		if err := m.CreateTable(&OrderItem{}); err != nil {
+			panic("migrate Failed.[ERROR]=>create order_item table failed.")
+		}
+		db.Exec("ALTER TABLE `order_item` COMMENT 'order item table'")
+
+		// 之前的订单只有一个商品，保存在 order 表的 product_id 以及 quantity 字段，迁移到 order_item 表
+		if m.HasColumn(&Order{}, "product_id") {
+			quantity := "1"
+			if m.HasColumn(&Order{}, "quantity") {
+				quantity = "GREATEST(`quantity`, 1)"
+			}
+			err := db.Exec("INSERT INTO `order_item` (`order_id`, `product_id`, `quantity`, `unit_price`, `subtotal`, `create_time`) " +
+				"SELECT `id`, `product_id`, " + quantity + ", `amount` / " + quantity + ", `amount`, `create_time` " +
+				"FROM `order` WHERE `product_id` > 0").Error
+			if err != nil {
+				panic("migrate Failed.[ERROR]=>migrate order items failed.")
+			}
+		}
+	}
+
+}
+
+// OrderItem 订单商品表。下单时保存商品单价快照，商品价格修改后不影响已有订单
+type OrderItem struct {
+	// 主键 ID
+	ID int64 `json:"id" gorm:"column:id;primaryKey;type:int(10);unique;autoIncrement;comment:主键id"`
+	// 订单 ID
+	OrderID int64 `json:"order_id" gorm:"column:order_id;index:idx_order_id;type:int(10);default:0;not null;comment:订单id"`
+	// 产品 ID
+	ProductID int64 `json:"product_id" gorm:"column:product_id;index:idx_product_id;type:int(10);default:0;not null;comment:产品id"`
+	// 购买数量
+	Quantity int64 `json:"quantity" gorm:"column:quantity;type:int(10);default:1;not null;comment:购买数量"`
+	// 下单时的产品单价
+	UnitPrice float32 `json:"unit_price" gorm:"column:unit_price;type:decimal(10,4);default:0;not null;comment:产品单价"`
+	// 小计。单价乘以购买数量
+	Subtotal float32 `json:"subtotal" gorm:"column:subtotal;type:decimal(10,4);default:0;not null;comment:小计"`
+	// 添加时间
+	CreateTime int64 `json:"create_time" gorm:"column:create_time;type:int(10);default:0;comment:create time'"`
+}
+
+// TableName 表名称
+func (*OrderItem) TableName() string {
+	return "order_item"
+}
@@ -7,4 +7,7 @@ import (
 // Migrate 迁移表格
 func Migrate(db *gorm.DB) {
 	MigrateOrderTable(db) // Migrate user table
+	MigrateOrderItemTable(db)
+	MigrateOrderTransitionTable(db)
+	MigrateBarrierTable(db)
 }
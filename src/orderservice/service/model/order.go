@@ -15,6 +15,15 @@ func MigrateOrderTable(db *gorm.DB) {
 		db.Exec("ALTER TABLE `order` COMMENT 'order table'")
 	}
 
+	// 补充后续新增的字段
+	for _, column := range []string{"Version"} {
+		if !m.HasColumn(&Order{}, column) {
+			if err := m.AddColumn(&Order{}, column); err != nil {
+				panic("migrate Failed.[ERROR]=>add order column " + column + " failed.")
+			}
+		}
+	}
+
 }
 
 // Order 订单表
@@ -31,15 +40,17 @@ type Order struct {
 	PayTime int64 `json:"pay_time" gorm:"column:pay_time;type:int(10);default:0;not null;comment:支付时间"`
 	// 用户 ID
 	UserID int64 `json:"user_id" gorm:"column:user_id;type:int(10);default:0;not null;comment:用户id"`
-	// 产品 ID
-	ProductID int64 `json:"product_id" gorm:"column:product_id;type:int(10);default:0;not null;comment:用户id"`
 	// 订单流程状态
 	OrderStatus int64 `json:"order_status" gorm:"column:order_status;type:int(10);default:0;not null;comment:订单状态"`
-	// 金额
+	// 金额。所有订单商品小计的合计
 	Amount float32 `json:"amount" gorm:"column:amount;type:decimal(10,4);default:0;not null;comment:订单金额"`
+	// 乐观锁版本号。每次修改订单状态时加 1
+	Version int64 `json:"version" gorm:"column:version;type:int(10);default:1;not null;comment:版本号"`
 	// 添加时间 / 更新时间
 	CreateTime int64 `json:"create_time" gorm:"column:create_time;type:int(10);default:0;comment:create time'"`
 	UpdateTime int64 `json:"update_time" gorm:"column:update_time;type:int(10);default:0;comment:update time"`
+	// 订单商品。保存在 order_item 表，由 Repository 单独加载
+	Items []*OrderItem `json:"items" gorm:"-"`
 }
 
 // TableName 标名称
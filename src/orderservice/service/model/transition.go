@@ -0,0 +1,43 @@
+package model
+
+import (
+	"gorm.io/gorm"
+)
+
+// MigrateOrderTransitionTable 迁移 order_transition 表
+func MigrateOrderTransitionTable(db *gorm.DB) {
+
+	m := db.Migrator()
+	if !m.HasTable(&OrderTransition{}) {
+		if err := m.CreateTable(&OrderTransition{}); err != nil {
+			panic("migrate Failed.[ERROR]=>create order_transition table failed.")
+		}
+		db.Exec("ALTER TABLE `order_transition` COMMENT 'order status transition history table'")
+	}
+
+}
+
+// OrderTransition 订单状态流转记录表
+type OrderTransition struct {
+	// 主键 ID
+	ID int64 `json:"id" gorm:"column:id;primaryKey;type:int(10);unique;autoIncrement;comment:主键id"`
+	// 订单 ID
+	OrderID int64 `json:"order_id" gorm:"column:order_id;index:idx_order_id;type:int(10);default:0;not null;comment:订单id"`
+	// 修改前后的订单状态
+	FromOrderStatus int64 `json:"from_order_status" gorm:"column:from_order_status;type:int(10);default:0;not null;comment:修改前订单状态"`
+	ToOrderStatus   int64 `json:"to_order_status" gorm:"column:to_order_status;type:int(10);default:0;not null;comment:修改后订单状态"`
+	// 修改前后的支付状态
+	FromPayStatus int64 `json:"from_pay_status" gorm:"column:from_pay_status;type:tinyint(2);default:0;not null;comment:修改前支付状态"`
+	ToPayStatus   int64 `json:"to_pay_status" gorm:"column:to_pay_status;type:tinyint(2);default:0;not null;comment:修改后支付状态"`
+	// 操作用户 ID
+	OperatorID int64 `json:"operator_id" gorm:"column:operator_id;type:int(10);default:0;not null;comment:操作用户id"`
+	// 备注
+	Remark string `json:"remark" gorm:"column:remark;type:varchar(255);default:'';comment:备注"`
+	// 添加时间
+	CreateTime int64 `json:"create_time" gorm:"column:create_time;type:int(10);default:0;comment:create time'"`
+}
+
+// TableName 表名称
+func (*OrderTransition) TableName() string {
+	return "order_transition"
+}
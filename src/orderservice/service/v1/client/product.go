@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"time"
 
+	"authservice/pkg/identity"
 	"orderservice/config"
 	productPBV1 "productservice/genproto/go/v1"
 )
@@ -24,8 +25,8 @@ func NewProductClient(conf *config.Config) (productPBV1.ProductServiceClient, er
 		ctx,
 		serverAddress,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
-		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor(), identity.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor(), identity.StreamClientInterceptor()),
 	)
 
 	if err != nil {
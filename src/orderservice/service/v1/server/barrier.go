@@ -0,0 +1,68 @@
+package serverV1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/dtm-labs/dtmgrpc"
+	"gorm.io/gorm"
+
+	"orderservice/service/model"
+)
+
+// callWithBarrier 在同一个本地事务中写入 dtm 子事务屏障并执行 fn。
+// 重复请求、空补偿以及悬挂请求只写入屏障，不执行 fn，直接返回成功。
+// 不是由 dtm 发起的请求没有事务信息，只在本地事务中执行 fn
+func (r *Repository) callWithBarrier(ctx context.Context, fn func(tx *gorm.DB) error) error {
+
+	tx := r.mysqlDB.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+	barrier, err := dtmgrpc.BarrierFromGrpc(ctx)
+	if err != nil {
+		if err = fn(tx); err != nil {
+			tx.Rollback()
+			return err
+		}
+		return tx.Commit().Error
+	}
+
+	sqlTx, ok := tx.Statement.ConnPool.(*sql.Tx)
+	if !ok {
+		tx.Rollback()
+		return errors.New("获取本地事务失败")
+	}
+	barrier.BarrierTableName = (&model.Barrier{}).TableName()
+	// Call 负责提交或者回滚本地事务
+	return barrier.Call(sqlTx, func(*sql.Tx) error {
+		return fn(tx)
+	})
+
+}
+
+// QueryPrepared dtm 二阶段消息回查。根据子事务屏障判断本地事务是否已经提交，
+// 没有提交时写入回滚屏障，之后提交的本地事务会因为屏障冲突而回滚
+func (r *Repository) QueryPrepared(ctx context.Context) error {
+
+	barrier, err := dtmgrpc.BarrierFromGrpc(ctx)
+	if err != nil {
+		return err
+	}
+	db, err := r.mysqlDB.DB()
+	if err != nil {
+		return err
+	}
+	return barrier.QueryPrepared(db)
+
+}
+
+// gormTx 在 dtm 开启的本地事务中执行 gorm 操作
+func (r *Repository) gormTx(ctx context.Context, tx *sql.Tx) *gorm.DB {
+
+	db := r.mysqlDB.WithContext(ctx).Session(&gorm.Session{SkipDefaultTransaction: true})
+	db.Statement.ConnPool = tx
+	return db
+
+}
@@ -0,0 +1,177 @@
+package serverV1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	orderPBV1 "orderservice/genproto/go/v1"
+)
+
+// idempotencyKeyPrefix 幂等键 key 前缀。完整 key 为 order_idempotency:{userId}:{idempotencyKey}
+const idempotencyKeyPrefix = "order_idempotency:"
+
+// 幂等请求的处理状态
+const (
+	idempotencyPending = "pending"
+	idempotencyDone    = "done"
+	idempotencyFailed  = "failed"
+)
+
+var (
+	// ErrIdempotencyMismatch 幂等键已经用于内容不同的请求
+	ErrIdempotencyMismatch = errors.New("幂等键已用于其他请求")
+	// ErrIdempotencyPending 相同幂等键的请求还在处理中
+	ErrIdempotencyPending = errors.New("相同的请求正在处理，请稍后重试")
+)
+
+// Idempotency 幂等键对应的请求以及处理结果
+type Idempotency struct {
+	// Fingerprint 请求内容的哈希值。相同幂等键的请求内容必须一致
+	Fingerprint string `json:"fingerprint"`
+	Status      string `json:"status"`
+	// OrderNo 首次请求生成的订单编号。重放时返回该订单编号
+	OrderNo string `json:"order_no"`
+	// Gid 首次请求生成的 saga 事务 ID
+	Gid string `json:"gid"`
+	// Code / Message saga 明确失败时的错误。重放时返回相同的错误
+	Code    codes.Code `json:"code,omitempty"`
+	Message string     `json:"message,omitempty"`
+}
+
+// idempotencyIDs 根据用户以及幂等键生成固定的订单编号以及 saga 事务 ID。
+// 提交结果不确定的请求重试时使用相同的事务 ID，由 dtm 去重，不会重复下单
+func idempotencyIDs(userID int64, key string) (orderNo string, gid string) {
+
+	name := idempotencyKey(userID, key)
+	orderNo = uuid.NewSHA1(uuid.NameSpaceOID, []byte(name+":order_no")).String()
+	gid = uuid.NewSHA1(uuid.NameSpaceOID, []byte(name+":gid")).String()
+	return orderNo, gid
+
+}
+
+// idempotencyKey 幂等键 key。按照用户隔离，不同用户可以使用相同的幂等键
+func idempotencyKey(userID int64, key string) string {
+	return idempotencyKeyPrefix + strconv.FormatInt(userID, 10) + ":" + key
+}
+
+// createFingerprint 下单请求内容的哈希值。不包含服务端设置的字段以及幂等键本身
+func createFingerprint(request *orderPBV1.CreateRequest) (string, error) {
+
+	req := proto.Clone(request).(*orderPBV1.CreateRequest)
+	req.OrderNo, req.UserId, req.IdempotencyKey = "", 0, ""
+	for _, item := range req.Items {
+		item.UnitPrice = 0
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+
+}
+
+// BeginIdempotency 占用幂等键。首次请求时保存处理中的记录并返回 true，
+// 重复请求时返回首次请求成功或者失败的记录。请求内容与首次请求不一致或者首次请求还在处理中时返回错误。
+// 处理中的记录过期后可以重新占用，订单编号以及事务 ID 与之前的请求相同
+func (r *Repository) BeginIdempotency(ctx context.Context, userID int64, request *orderPBV1.CreateRequest) (*Idempotency, bool, error) {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	fingerprint, err := createFingerprint(request)
+	if err != nil {
+		return nil, false, r.span.Error(span, err.Error())
+	}
+	orderNo, gid := idempotencyIDs(userID, request.IdempotencyKey)
+	record := &Idempotency{
+		Fingerprint: fingerprint,
+		Status:      idempotencyPending,
+		OrderNo:     orderNo,
+		Gid:         gid,
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, false, r.span.Error(span, err.Error())
+	}
+
+	// 处理中的记录使用较短的有效期，服务异常退出后客户端可以重试
+	key := idempotencyKey(userID, request.IdempotencyKey)
+	ok, err := r.redis.SetNX(ctx, key, data, r.conf.Idempotency.PendingTTL).Result()
+	if err != nil {
+		return nil, false, r.span.Error(span, err.Error())
+	}
+	if ok {
+		return record, true, nil
+	}
+
+	stored, err := r.redis.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		// 首次请求的记录刚好过期，按照处理中返回，由客户端重试
+		_ = r.span.Error(span, ErrIdempotencyPending.Error())
+		return nil, false, ErrIdempotencyPending
+	}
+	if err != nil {
+		return nil, false, r.span.Error(span, err.Error())
+	}
+	existing := &Idempotency{}
+	if err = json.Unmarshal(stored, existing); err != nil {
+		return nil, false, r.span.Error(span, err.Error())
+	}
+	switch {
+	case existing.Fingerprint != fingerprint:
+		_ = r.span.Error(span, ErrIdempotencyMismatch.Error())
+		return nil, false, ErrIdempotencyMismatch
+	case existing.Status == idempotencyPending:
+		_ = r.span.Error(span, ErrIdempotencyPending.Error())
+		return nil, false, ErrIdempotencyPending
+	}
+	return existing, false, nil
+
+}
+
+// FinishIdempotency 保存处理结果。result 为 nil 时保存成功的结果，否则保存失败的错误。
+// 有效期内相同幂等键的请求直接返回该结果
+func (r *Repository) FinishIdempotency(ctx context.Context, userID int64, key string, record *Idempotency, result error) error {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	record.Status = idempotencyDone
+	if result != nil {
+		st := status.Convert(result)
+		record.Status, record.Code, record.Message = idempotencyFailed, st.Code(), st.Message()
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	if err = r.redis.Set(ctx, idempotencyKey(userID, key), data, r.conf.Idempotency.TTL).Err(); err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	return nil
+
+}
+
+// AbortIdempotency 释放幂等键。只用于还没有提交 saga 的失败，客户端可以使用相同的幂等键重试
+func (r *Repository) AbortIdempotency(ctx context.Context, userID int64, key string) error {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	if err := r.redis.Del(ctx, idempotencyKey(userID, key)).Err(); err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	return nil
+
+}
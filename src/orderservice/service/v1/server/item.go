@@ -0,0 +1,154 @@
+package serverV1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	orderPBV1 "orderservice/genproto/go/v1"
+	"orderservice/service/model"
+	productPBV1 "productservice/genproto/go/v1"
+)
+
+// 产品禁用状态。与 productservice 的 is_disable 字段一致
+const productDisabled = 1
+
+var (
+	// ErrDuplicateItem 下单商品中同一个商品出现了多次
+	ErrDuplicateItem = errors.New("同一个商品只能出现一次，请合并购买数量")
+	// ErrProductUnavailable 下单商品不存在或者已经禁用
+	ErrProductUnavailable = errors.New("商品不存在或者已下架")
+	// ErrOutOfStock 下单商品库存不足
+	ErrOutOfStock = errors.New("商品库存不足")
+)
+
+// OrderItemModel order_item 表模型
+func (r *Repository) OrderItemModel() *gorm.DB {
+	itemModel := &model.OrderItem{}
+	return r.mysqlDB.Table(itemModel.TableName())
+}
+
+// PriceItems 检查下单商品并设置商品当前单价作为订单的价格快照
+func (r *Repository) PriceItems(ctx context.Context, items []*orderPBV1.CreateItem) error {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	seen := make(map[int64]bool, len(items))
+	for _, item := range items {
+		if seen[item.ProductId] {
+			_ = r.span.Error(span, ErrDuplicateItem.Error())
+			return ErrDuplicateItem
+		}
+		seen[item.ProductId] = true
+
+		resp, err := r.product.Detail(ctx, &productPBV1.DetailRequest{Id: item.ProductId})
+		if err != nil {
+			return r.span.Error(span, err.Error())
+		}
+		// 产品不存在时 productservice 不返回数据
+		if resp.ProtoAnyData == nil {
+			_ = r.span.Error(span, ErrProductUnavailable.Error())
+			return ErrProductUnavailable
+		}
+		product := &productPBV1.ProductDetail{}
+		if err = resp.ProtoAnyData.UnmarshalTo(product); err != nil {
+			return r.span.Error(span, err.Error())
+		}
+		if product.IsDisable == productDisabled {
+			_ = r.span.Error(span, ErrProductUnavailable.Error())
+			return ErrProductUnavailable
+		}
+		// 提前检查可售库存，库存不足时不提交 saga。并发下单时由预留库存分支保证不超卖
+		if product.Stock < item.Quantity {
+			_ = r.span.Error(span, ErrOutOfStock.Error())
+			return ErrOutOfStock
+		}
+		item.UnitPrice = product.Price
+	}
+	return nil
+
+}
+
+// FailureReason 下单 saga 业务失败后检查失败原因。dtm 不返回分支的错误详情，通过商品当前的库存判断：
+// 库存不足返回 ErrOutOfStock，商品不存在返回 ErrProductUnavailable，无法确定时返回 nil
+func (r *Repository) FailureReason(ctx context.Context, items []*orderPBV1.CreateItem) error {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	for _, item := range items {
+		resp, err := r.product.StockInfo(ctx, &productPBV1.DetailRequest{Id: item.ProductId})
+		if status.Code(err) == codes.NotFound {
+			return ErrProductUnavailable
+		}
+		if err != nil {
+			_ = r.span.Error(span, err.Error())
+			return nil
+		}
+		stock := &productPBV1.StockDetail{}
+		if err = resp.ProtoAnyData.UnmarshalTo(stock); err != nil {
+			_ = r.span.Error(span, err.Error())
+			return nil
+		}
+		if stock.Available < item.Quantity {
+			return ErrOutOfStock
+		}
+	}
+	return nil
+
+}
+
+// itemStatus 转换下单商品错误。错误详情中的 reason 与产品服务的 ErrorReason 一致，不是商品错误时返回 nil
+func itemStatus(err error) error {
+
+	var code codes.Code
+	var reason productPBV1.ErrorReason
+	switch {
+	case errors.Is(err, ErrDuplicateItem):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrProductUnavailable):
+		code, reason = codes.FailedPrecondition, productPBV1.ErrorReason_ERROR_REASON_PRODUCT_NOT_FOUND
+	case errors.Is(err, ErrOutOfStock):
+		code, reason = codes.FailedPrecondition, productPBV1.ErrorReason_ERROR_REASON_OUT_OF_STOCK
+	default:
+		return nil
+	}
+	st, detailErr := status.New(code, err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: reason.String(),
+		Domain: productPBV1.ProductService_ServiceDesc.ServiceName,
+	})
+	if detailErr != nil {
+		return status.Error(code, err.Error())
+	}
+	return st.Err()
+
+}
+
+// loadItems 批量加载订单商品
+func (r *Repository) loadItems(orders ...*model.Order) error {
+
+	if len(orders) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(orders))
+	byID := make(map[int64]*model.Order, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.ID)
+		byID[order.ID] = order
+	}
+	var items []*model.OrderItem
+	if err := r.OrderItemModel().Where("order_id IN ?", ids).Order("id ASC").Find(&items).Error; err != nil {
+		return err
+	}
+	for _, item := range items {
+		order := byID[item.OrderID]
+		order.Items = append(order.Items, item)
+	}
+	return nil
+
+}
@@ -2,16 +2,26 @@ package serverV1
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"time"
 
 	Jgrpc_otelspan "github.com/janrs-io/Jgrpc-otel-span"
-	"google.golang.org/protobuf/encoding/protojson"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 
+	"authservice/pkg/identity"
+	"authservice/pkg/rbac"
 	"orderservice/config"
 	orderPBV1 "orderservice/genproto/go/v1"
 	"orderservice/service/model"
+	productPBV1 "productservice/genproto/go/v1"
+)
+
+const (
+	// defaultPageSize 订单列表默认每页数量
+	defaultPageSize = 20
+	// maxPageSize 订单列表每页最大数量
+	maxPageSize = 100
 )
 
 // Repository 数据仓库层
@@ -19,6 +29,9 @@ type Repository struct {
 	mysqlDB *gorm.DB
 	conf    *config.Config
 	span    *Jgrpc_otelspan.OtelSpan
+	rbac    *rbac.Store
+	redis   *redis.Client
+	product productPBV1.ProductServiceClient
 }
 
 // NewRepository 实例化 Repository
@@ -26,11 +39,17 @@ func NewRepository(
 	mysqlDB *gorm.DB,
 	conf *config.Config,
 	span *Jgrpc_otelspan.OtelSpan,
+	rbac *rbac.Store,
+	redis *redis.Client,
+	product productPBV1.ProductServiceClient,
 ) *Repository {
 	return &Repository{
 		mysqlDB: mysqlDB,
 		conf:    conf,
 		span:    span,
+		rbac:    rbac,
+		redis:   redis,
+		product: product,
 	}
 }
 
@@ -40,33 +59,73 @@ func (r *Repository) OrderModel() *gorm.DB {
 	return r.mysqlDB.Table(orderModel.TableName())
 }
 
-// Create 添加订单
+// Create 添加订单以及订单商品。saga 分支接口，通过子事务屏障保证幂等
+// 订单金额根据 CreateSaga 设置的商品单价计算，不使用客户端传入的金额
 func (r *Repository) Create(ctx context.Context, request *orderPBV1.CreateRequest) error {
 
 	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
 	defer span.End()
 
-	jsonStr := protojson.Format(request)
-	m := make(map[string]interface{})
-	if err := json.Unmarshal([]byte(jsonStr), &m); err != nil {
-		return err
+	now := time.Now().Unix()
+	// 新订单的状态由订单状态机决定，支付时间在支付时设置
+	order := &model.Order{
+		OrderNo:     request.OrderNo,
+		PaymentType: request.PaymentType,
+		UserID:      request.UserId,
+		OrderStatus: int64(orderPBV1.OrderStatus_ORDER_STATUS_NORMAL),
+		PayStatus:   int64(orderPBV1.PayStatus_PAY_STATUS_NOT_PAY),
+		Version:     1,
+		CreateTime:  now,
+		UpdateTime:  now,
 	}
-	m["create_time"] = time.Now().Unix()
-	m["update_time"] = time.Now().Unix()
-
-	result := r.OrderModel().Create(m)
-	if result.Error != nil {
-		return r.span.Error(span, result.Error.Error())
+	for _, v := range request.Items {
+		item := &model.OrderItem{
+			ProductID:  v.ProductId,
+			Quantity:   v.Quantity,
+			UnitPrice:  v.UnitPrice,
+			Subtotal:   v.UnitPrice * float32(v.Quantity),
+			CreateTime: now,
+		}
+		order.Amount += item.Subtotal
+		order.Items = append(order.Items, item)
 	}
 
-	if result.RowsAffected <= 0 {
-		return r.span.Error(span, "添加失败")
+	err := r.callWithBarrier(ctx, func(tx *gorm.DB) error {
+		result := tx.Table(order.TableName()).Create(order)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected <= 0 {
+			return errors.New("添加失败")
+		}
+		for _, item := range order.Items {
+			item.OrderID = order.ID
+		}
+		return tx.Table((&model.OrderItem{}).TableName()).Create(order.Items).Error
+	})
+	if err != nil {
+		return r.span.Error(span, err.Error())
 	}
 	return nil
 
 }
 
-// CreateRevert 添加订单失败补偿
+// OrderByNo 根据订单编号获取订单。订单不存在时返回 gorm.ErrRecordNotFound
+func (r *Repository) OrderByNo(ctx context.Context, orderNo string) (*model.Order, error) {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	order := &model.Order{}
+	if err := r.OrderModel().Where("order_no = ?", orderNo).Take(order).Error; err != nil {
+		_ = r.span.Error(span, err.Error())
+		return nil, err
+	}
+	return order, nil
+
+}
+
+// CreateRevert 添加订单失败补偿。saga 分支接口，通过子事务屏障保证幂等并且忽略空补偿
 func (r *Repository) CreateRevert(ctx context.Context, request *orderPBV1.CreateRequest) (err error) {
 
 	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
@@ -75,36 +134,275 @@ func (r *Repository) CreateRevert(ctx context.Context, request *orderPBV1.Create
 	m := make(map[string]any)
 	m["order_status"] = orderPBV1.OrderStatus_ORDER_STATUS_UNDEFINED
 	m["update_time"] = time.Now().Unix()
-	return r.OrderModel().Where("order_no = ?", request.OrderNo).Updates(m).Error
+	err = r.callWithBarrier(ctx, func(tx *gorm.DB) error {
+		return tx.Table((&model.Order{}).TableName()).Where("order_no = ?", request.OrderNo).Updates(m).Error
+	})
+	if err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	return nil
 
 }
 
-// Update 更新订单
-func (r *Repository) Update(request *orderPBV1.UpdateRequest) error {
-	return nil
+// OrderTransitionModel order_transition 表模型
+func (r *Repository) OrderTransitionModel() *gorm.DB {
+	transitionModel := &model.OrderTransition{}
+	return r.mysqlDB.Table(transitionModel.TableName())
+}
+
+// Update 按照订单状态机修改订单状态。非管理员只能修改自己的订单
+func (r *Repository) Update(ctx context.Context, request *orderPBV1.UpdateRequest, operatorID int64, isAdmin bool) (*model.Order, error) {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	order, err := r.transition(ctx, request.Id, request.Version, request.OrderStatus, operatorID, isAdmin, request.Remark)
+	if err != nil {
+		_ = r.span.Error(span, err.Error())
+		return nil, err
+	}
+	if err = r.loadItems(order); err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	return order, nil
+
 }
 
-// Delete 删除订单
-func (r *Repository) Delete(request *orderPBV1.DetailRequest) error {
+// Delete 软删除订单。只有已完成或者已取消的订单可以删除，非管理员只能删除自己的订单
+func (r *Repository) Delete(ctx context.Context, request *orderPBV1.DeleteRequest, operatorID int64, isAdmin bool) error {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	deleted := int64(orderPBV1.OrderStatus_ORDER_STATUS_DELETED)
+	if _, err := r.transition(ctx, request.Id, 0, deleted, operatorID, isAdmin, ""); err != nil {
+		_ = r.span.Error(span, err.Error())
+		return err
+	}
 	return nil
+
+}
+
+// transition 修改订单状态并记录流转历史。version 为 0 时使用订单当前的版本号
+func (r *Repository) transition(ctx context.Context, orderID, version, to, operatorID int64, isAdmin bool, remark string) (*model.Order, error) {
+
+	order := &model.Order{}
+	if err := r.OrderModel().Where("id = ?", orderID).Take(order).Error; err != nil {
+		return nil, err
+	}
+	// 不暴露其他用户的订单是否存在
+	if !isAdmin && order.UserID != operatorID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	if version == 0 {
+		version = order.Version
+	}
+	if version != order.Version {
+		return nil, ErrVersionConflict
+	}
+	if err := checkTransition(order.OrderStatus, to, isAdmin); err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	// 超过支付期限的订单不能再支付，由 OrderSweeper 取消
+	if to == int64(orderPBV1.OrderStatus_ORDER_STATUS_PAID) &&
+		order.CreateTime+int64(r.conf.Payment.Timeout.Seconds()) <= now {
+		return nil, ErrReservationExpired
+	}
+
+	history := &model.OrderTransition{
+		OrderID:         order.ID,
+		FromOrderStatus: order.OrderStatus,
+		ToOrderStatus:   to,
+		FromPayStatus:   order.PayStatus,
+		ToPayStatus:     nextPayStatus(order.PayStatus, to),
+		OperatorID:      operatorID,
+		Remark:          remark,
+		CreateTime:      now,
+	}
+	m := map[string]any{
+		"order_status": history.ToOrderStatus,
+		"pay_status":   history.ToPayStatus,
+		"version":      gorm.Expr("version + 1"),
+		"update_time":  now,
+	}
+	if to == int64(orderPBV1.OrderStatus_ORDER_STATUS_PAID) {
+		m["pay_time"] = now
+		order.PayTime = now
+	}
+
+	// 通过版本号条件更新占用这次状态流转，并发的状态流转只有一个可以成功
+	claim := func(tx *gorm.DB) error {
+		result := tx.Table(order.TableName()).
+			Where("id = ? AND version = ?", order.ID, version).
+			Updates(m)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrVersionConflict
+		}
+		return tx.Table(history.TableName()).Create(history).Error
+	}
+	var err error
+	if to == int64(orderPBV1.OrderStatus_ORDER_STATUS_CANCELLED) {
+		err = r.cancelWithRelease(ctx, order.OrderNo, claim)
+	} else {
+		err = r.mysqlDB.WithContext(ctx).Transaction(claim)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	order.OrderStatus = history.ToOrderStatus
+	order.PayStatus = history.ToPayStatus
+	order.Version = version + 1
+	order.UpdateTime = now
+
+	// 订单状态提交后再确认预留库存
+	if to == int64(orderPBV1.OrderStatus_ORDER_STATUS_PAID) {
+		if err = r.confirmReservation(ctx, order); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+
 }
 
-// List 获取订单列表
-func (r *Repository) List() {
+// CancelExpiredOrders 取消最多 limit 个超过支付期限还没有支付的订单，返回取消的数量
+// 取消时通过 cancelWithRelease 释放预留库存，已经被其他请求修改的订单直接跳过
+func (r *Repository) CancelExpiredOrders(ctx context.Context, limit int) (int, error) {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	deadline := time.Now().Add(-r.conf.Payment.Timeout).Unix()
+	var ids []int64
+	err := r.OrderModel().WithContext(ctx).
+		Where("order_status = ? AND create_time <= ?", orderPBV1.OrderStatus_ORDER_STATUS_NORMAL, deadline).
+		Order("id ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return 0, r.span.Error(span, err.Error())
+	}
+
+	cancelled := 0
+	to := int64(orderPBV1.OrderStatus_ORDER_STATUS_CANCELLED)
+	for _, id := range ids {
+		_, err = r.transition(ctx, id, 0, to, 0, true, "超时未支付，自动取消")
+		switch {
+		case err == nil:
+			cancelled++
+		case errors.Is(err, ErrVersionConflict), errors.Is(err, ErrIllegalTransition):
+		default:
+			return cancelled, r.span.Error(span, err.Error())
+		}
+	}
+	return cancelled, nil
 
 }
 
-// Detail 获取订单详情
-func (r *Repository) Detail(ctx context.Context, request *orderPBV1.DetailRequest) (*model.Order, error) {
+// List 分页获取订单列表。userID 大于 0 时只查询该用户的订单
+// 请求中带有游标时按照 id 倒序从游标之后开始查询，否则按照页码查询
+func (r *Repository) List(ctx context.Context, request *orderPBV1.ListRequest, userID int64) ([]*model.Order, int64, error) {
 
 	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
 	defer span.End()
 
-	user := &model.Order{}
-	err := r.OrderModel().First(&user, request.Id).Error
+	page, pageSize := int(request.Page), int(request.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	query := r.OrderModel()
+	if userID > 0 {
+		query = query.Where("user_id = ?", userID)
+	}
+	if request.OrderStatus > 0 {
+		query = query.Where("order_status = ?", request.OrderStatus)
+	} else {
+		query = query.Where("order_status <> ?", orderPBV1.OrderStatus_ORDER_STATUS_DELETED)
+	}
+	if request.PayStatus > 0 {
+		query = query.Where("pay_status = ?", request.PayStatus)
+	}
+	if request.PaymentType > 0 {
+		query = query.Where("payment_type = ?", request.PaymentType)
+	}
+	if request.ProductId > 0 {
+		query = query.Where("id IN (?)", r.OrderItemModel().Select("order_id").Where("product_id = ?", request.ProductId))
+	}
+	if request.StartTime > 0 {
+		query = query.Where("create_time >= ?", request.StartTime)
+	}
+	if request.EndTime > 0 {
+		query = query.Where("create_time <= ?", request.EndTime)
+	}
+
+	// 统计总数以及查询列表共用查询条件
+	query = query.Session(&gorm.Session{})
+	var total int64
+	if result := query.Count(&total); result.Error != nil {
+		return nil, 0, r.span.Error(span, result.Error.Error())
+	}
+	list := query.Order("id DESC").Limit(pageSize)
+	if request.Cursor > 0 {
+		list = list.Where("id < ?", request.Cursor)
+	} else {
+		list = list.Offset((page - 1) * pageSize)
+	}
+	var orders []*model.Order
+	if result := list.Find(&orders); result.Error != nil {
+		return nil, 0, r.span.Error(span, result.Error.Error())
+	}
+	if err := r.loadItems(orders...); err != nil {
+		return nil, 0, r.span.Error(span, err.Error())
+	}
+	return orders, total, nil
+
+}
+
+// IsAdmin 调用方是否拥有管理所有用户订单的权限
+func (r *Repository) IsAdmin(ctx context.Context, principal *identity.Principal) (bool, error) {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	ok, err := r.rbac.HasPermission(ctx, principal.Roles, r.conf.Rbac.AdminPermission)
 	if err != nil {
-		return user, r.span.Error(span, err.Error())
+		return false, r.span.Error(span, err.Error())
+	}
+	return ok, nil
+
+}
+
+// Detail 获取订单详情。userID 大于 0 时只能获取该用户未删除的订单，其他用户的订单按照不存在处理
+func (r *Repository) Detail(ctx context.Context, request *orderPBV1.DetailRequest, userID int64) (*model.Order, error) {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	query := r.OrderModel().Where("id = ?", request.Id)
+	if userID > 0 {
+		query = query.
+			Where("user_id = ?", userID).
+			Where("order_status <> ?", orderPBV1.OrderStatus_ORDER_STATUS_DELETED)
+	}
+	order := &model.Order{}
+	if err := query.Take(order).Error; err != nil {
+		_ = r.span.Error(span, err.Error())
+		return nil, err
+	}
+	if err := r.loadItems(order); err != nil {
+		return nil, r.span.Error(span, err.Error())
 	}
-	return user, err
+	return order, nil
 
 }
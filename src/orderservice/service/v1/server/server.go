@@ -3,7 +3,6 @@ package serverV1
 import (
 	"context"
 	"errors"
-	"gorm.io/gorm"
 
 	"github.com/dtm-labs/dtmgrpc"
 	"github.com/go-kit/log"
@@ -12,9 +11,13 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
 
+	"authservice/pkg/identity"
 	"orderservice/config"
 	orderPBV1 "orderservice/genproto/go/v1"
+	"orderservice/service/model"
 	productPBV1 "productservice/genproto/go/v1"
 )
 
@@ -48,10 +51,12 @@ func NewServer(
 // Create 添加订单
 func (s *Server) Create(ctx context.Context, request *orderPBV1.CreateRequest) (*orderPBV1.Response, error) {
 
+	// 商品以及库存在提交 saga 之前已经校验，这里的错误都是数据库等临时错误。
+	// 不能返回 Aborted，由 dtm 重试，子事务屏障保证重试不会重复创建订单
 	err := s.repo.Create(ctx, request)
 	if err != nil {
-		_ = level.Error(s.logger).Log("msg", "执行了创建订单失败")
-		return nil, status.Error(codes.Aborted, "创建订单失败，错误[1]："+err.Error())
+		_ = level.Error(s.logger).Log("msg", "创建订单失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.Internal, "创建订单失败，错误[1]："+err.Error())
 	}
 	return &orderPBV1.Response{}, nil
 
@@ -60,69 +65,208 @@ func (s *Server) Create(ctx context.Context, request *orderPBV1.CreateRequest) (
 // CreateRevert 添加订单失败补偿接口
 func (s *Server) CreateRevert(ctx context.Context, request *orderPBV1.CreateRequest) (*orderPBV1.Response, error) {
 
+	// 补偿操作不能返回 Aborted，失败时由 dtm 重试直到成功
 	if err := s.repo.CreateRevert(ctx, request); err != nil {
 		_ = level.Error(s.logger).Log("msg", "执行添加订单失败回滚失败，错误："+err.Error())
-		return nil, status.Error(codes.Aborted, "执行创建订单失败补偿回滚失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.Internal, "执行创建订单失败补偿回滚失败，错误[1]："+err.Error())
 	}
 	return &orderPBV1.Response{}, nil
 
 }
 
-// CreateSaga 添加订单事务接口
+// errSubmitUnknown 提交 saga 的结果不确定。saga 可能已经在 dtm 中执行，客户端需要使用相同的幂等键重试
+var errSubmitUnknown = status.Error(codes.Unavailable, "下单结果未知，请稍后使用相同的幂等键重试")
+
+// CreateSaga 添加订单事务接口。带有幂等键时，相同幂等键的重复请求返回首次请求的结果
 func (s *Server) CreateSaga(ctx context.Context, request *orderPBV1.CreateRequest) (*orderPBV1.Response, error) {
 
-	// 扣产品库存事务
-	decreaseProductReq := &productPBV1.DecreaseStockRequest{}
-	decreaseProductReq.Quantity = 1
-	decreaseProductReq.Id = request.ProductId
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	request.UserId = principal.UserID
+	if request.IdempotencyKey == "" {
+		if err = s.prepareCreateSaga(ctx, request); err != nil {
+			return nil, err
+		}
+		return s.submitCreateSaga(ctx, request, uuid.NewString(), uuid.NewString())
+	}
+
+	record, isNew, err := s.repo.BeginIdempotency(ctx, principal.UserID, request)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrIdempotencyMismatch):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, ErrIdempotencyPending):
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		_ = level.Error(s.logger).Log("msg", "创建订单失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "创建订单失败")
+	}
+	if !isNew {
+		if record.Status == idempotencyFailed {
+			return nil, status.Error(record.Code, record.Message)
+		}
+		return createResponse(record.OrderNo)
+	}
+
+	// 之前提交结果不确定的请求已经创建了订单时直接返回结果，不重新提交。saga 补偿后订单状态为 UNDEFINED
+	if order, err := s.repo.OrderByNo(ctx, record.OrderNo); err == nil {
+		var result error
+		if order.OrderStatus == int64(orderPBV1.OrderStatus_ORDER_STATUS_UNDEFINED) {
+			result = status.Error(codes.FailedPrecondition, "创建订单失败")
+		}
+		s.finishIdempotency(ctx, principal.UserID, request.IdempotencyKey, record, result)
+		if result != nil {
+			return nil, result
+		}
+		return createResponse(record.OrderNo)
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		_ = level.Error(s.logger).Log("msg", "创建订单失败，错误[2]："+err.Error())
+		return nil, errSubmitUnknown
+	}
+
+	// 还没有提交 saga 的失败释放幂等键，客户端可以使用相同的幂等键重试
+	if err = s.prepareCreateSaga(ctx, request); err != nil {
+		if abortErr := s.repo.AbortIdempotency(ctx, principal.UserID, request.IdempotencyKey); abortErr != nil {
+			_ = level.Error(s.logger).Log("msg", "释放幂等键失败，错误："+abortErr.Error())
+		}
+		return nil, err
+	}
+
+	resp, err := s.submitCreateSaga(ctx, request, record.OrderNo, record.Gid)
+	// 提交结果不确定时保留处理中的记录，到期后使用相同的事务 ID 重新提交，由 dtm 去重
+	if errors.Is(err, errSubmitUnknown) {
+		return nil, err
+	}
+	s.finishIdempotency(ctx, principal.UserID, request.IdempotencyKey, record, err)
+	return resp, err
+
+}
+
+// finishIdempotency 保存幂等请求的结果。订单已经提交，保存结果失败时只记录日志
+func (s *Server) finishIdempotency(ctx context.Context, userID int64, key string, record *Idempotency, result error) {
+
+	if err := s.repo.FinishIdempotency(ctx, userID, key, record, result); err != nil {
+		_ = level.Error(s.logger).Log("msg", "保存幂等键结果失败，错误："+err.Error())
+	}
+
+}
+
+// prepareCreateSaga 检查下单商品并设置商品单价快照。失败时还没有提交 saga
+func (s *Server) prepareCreateSaga(ctx context.Context, request *orderPBV1.CreateRequest) error {
 
-	productDecreaseStock := "product.rgrpc-dev:50051/proto.product.v1.ProductService/DecreaseStock"
-	productDecreaseStockRevert := "product.rgrpc-dev:50051/proto.product.v1.ProductService/DecreaseStockRevert"
+	if err := s.repo.PriceItems(ctx, request.Items); err != nil {
+		if st := itemStatus(err); st != nil {
+			return st
+		}
+		_ = level.Error(s.logger).Log("msg", "获取商品价格失败，错误："+err.Error())
+		return status.Error(codes.FailedPrecondition, "创建订单失败")
+	}
+	return nil
+
+}
+
+// submitCreateSaga 提交下单 saga 事务。每个订单商品单独预留库存，任意商品失败时 dtm 补偿所有已经执行的分支。
+// dtm 明确返回失败时返回对应的错误，提交结果不确定时返回 errSubmitUnknown
+func (s *Server) submitCreateSaga(ctx context.Context, request *orderPBV1.CreateRequest, orderNo string, gid string) (*orderPBV1.Response, error) {
+
+	sagaConf := s.conf.Saga
 
 	// 创建订单事务
-	orderNo := uuid.NewString()
 	request.OrderNo = orderNo
-	createOrder := "order.rgrpc-dev:50051/proto.order.v1.OrderService/Create"
-	createOrderRevert := "order.rgrpc-dev:50051/proto.order.v1.OrderService/CreateRevert"
 
-	saga := dtmgrpc.NewSagaGrpc("dtm-svc.dtm-prod:36790", uuid.NewString())
-	saga.Add(createOrder, createOrderRevert, request)
-	saga.Add(productDecreaseStock, productDecreaseStockRevert, decreaseProductReq)
-	saga.WaitResult = true
+	saga := dtmgrpc.NewSagaGrpc(sagaConf.DtmServer, gid)
+	saga.Add(
+		sagaBranch(sagaConf.OrderTarget, orderPBV1.OrderService_Create_FullMethodName),
+		sagaBranch(sagaConf.OrderTarget, orderPBV1.OrderService_CreateRevert_FullMethodName),
+		request,
+	)
+	// 预留产品库存事务。订单支付后确认预留，超时未支付时由产品服务释放
+	for _, item := range request.Items {
+		saga.Add(
+			sagaBranch(sagaConf.ProductTarget, productPBV1.ProductService_ReserveStock_FullMethodName),
+			sagaBranch(sagaConf.ProductTarget, productPBV1.ProductService_ReserveStockRevert_FullMethodName),
+			&productPBV1.ReserveStockRequest{
+				OrderNo:  orderNo,
+				Id:       item.ProductId,
+				Quantity: item.Quantity,
+			},
+		)
+	}
+	saga.WaitResult = sagaConf.WaitResult
+	saga.TimeoutToFail = int64(sagaConf.TimeoutToFail.Seconds())
+	saga.RequestTimeout = int64(sagaConf.RequestTimeout.Seconds())
+	saga.RetryInterval = int64(sagaConf.RetryInterval.Seconds())
 	if err := saga.Submit(); err != nil {
 		_ = level.Error(s.logger).Log("msg", "创建订单失败，错误："+err.Error())
-		return nil, status.Error(codes.Aborted, "创建订单失败")
+		// 分支业务失败时 dtm 返回 Aborted，所有分支已经补偿
+		if status.Code(err) == codes.Aborted {
+			if st := itemStatus(s.repo.FailureReason(ctx, request.Items)); st != nil {
+				return nil, st
+			}
+			return nil, status.Error(codes.FailedPrecondition, "创建订单失败："+status.Convert(err).Message())
+		}
+		return nil, errSubmitUnknown
+	}
+	return createResponse(orderNo)
+
+}
+
+// createResponse 下单接口返回数据
+func createResponse(orderNo string) (*orderPBV1.Response, error) {
+
+	anyData, err := anypb.New(&orderPBV1.CreateResponse{OrderNo: orderNo})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &orderPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// sagaBranch dtm 调用分支接口的地址。fullMethod 使用生成的 grpc 代码中的方法名称，避免与 proto 定义不一致
+func sagaBranch(target string, fullMethod string) string {
+	return target + fullMethod
+}
+
+// QueryPrepared dtm 二阶段消息回查接口。取消订单的本地事务状态不确定时由 dtm 调用
+func (s *Server) QueryPrepared(ctx context.Context, _ *emptypb.Empty) (*orderPBV1.Response, error) {
+
+	if err := s.repo.QueryPrepared(ctx); err != nil {
+		return nil, dtmgrpc.DtmError2GrpcError(err)
 	}
 	return &orderPBV1.Response{}, nil
 
 }
 
-// Detail 获取订单详情
+// Detail 获取订单详情。普通用户只能获取自己的订单，管理员可以获取所有订单
 func (s *Server) Detail(ctx context.Context, request *orderPBV1.DetailRequest) (*orderPBV1.Response, error) {
 
 	resp := &orderPBV1.Response{}
 
-	result, err := s.repo.Detail(ctx, request)
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isAdmin, err := s.repo.IsAdmin(ctx, principal)
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "获取订单详情失败，错误："+err.Error())
+		return nil, status.Errorf(codes.FailedPrecondition, "获取订单详情失败")
+	}
+	userID := principal.UserID
+	if isAdmin {
+		userID = 0
+	}
+
+	result, err := s.repo.Detail(ctx, request, userID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return resp, nil
+			return nil, status.Error(codes.NotFound, "订单不存在")
 		}
 		_ = level.Error(s.logger).Log("msg", "获取订单详情失败，错误："+err.Error())
 		return nil, status.Errorf(codes.FailedPrecondition, "获取订单详情失败")
 	}
-	orderDetail := &orderPBV1.OrderDetail{}
-	orderDetail.Id = result.ID
-	orderDetail.OrderNo = result.OrderNo
-	orderDetail.ProductId = result.ProductID
-	orderDetail.PaymentType = result.PaymentType
-	orderDetail.OrderStatus = result.OrderStatus
-	orderDetail.UserId = result.UserID
-	orderDetail.UpdateTime = result.UpdateTime
-	orderDetail.CreateTime = result.CreateTime
-	orderDetail.PayTime = result.PayTime
-	orderDetail.Amount = result.Amount
-
-	anyData, err := anypb.New(orderDetail)
+	anyData, err := anypb.New(orderDetail(result))
 	if err != nil {
 		_ = level.Error(s.logger).Log("msg", "获取订单详情失败，错误："+err.Error())
 		return nil, status.Errorf(codes.FailedPrecondition, "获取订单想详情失败")
@@ -131,3 +275,163 @@ func (s *Server) Detail(ctx context.Context, request *orderPBV1.DetailRequest) (
 	return resp, nil
 
 }
+
+// Update 修改订单状态
+func (s *Server) Update(ctx context.Context, request *orderPBV1.UpdateRequest) (*orderPBV1.Response, error) {
+
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isAdmin, err := s.repo.IsAdmin(ctx, principal)
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "修改订单失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "修改订单失败")
+	}
+	order, err := s.repo.Update(ctx, request, principal.UserID, isAdmin)
+	if err != nil {
+		if st := transitionStatus(err); st != nil {
+			return nil, st
+		}
+		_ = level.Error(s.logger).Log("msg", "修改订单失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "修改订单失败")
+	}
+	anyData, err := anypb.New(orderDetail(order))
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "修改订单失败，错误[3]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &orderPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// Delete 删除订单
+func (s *Server) Delete(ctx context.Context, request *orderPBV1.DeleteRequest) (*orderPBV1.Response, error) {
+
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isAdmin, err := s.repo.IsAdmin(ctx, principal)
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "删除订单失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "删除订单失败")
+	}
+	if err = s.repo.Delete(ctx, request, principal.UserID, isAdmin); err != nil {
+		if st := transitionStatus(err); st != nil {
+			return nil, st
+		}
+		_ = level.Error(s.logger).Log("msg", "删除订单失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "删除订单失败")
+	}
+	return &orderPBV1.Response{}, nil
+
+}
+
+// transitionStatus 转换订单状态流转错误。不是状态流转错误时返回 nil
+func transitionStatus(err error) error {
+
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "订单不存在")
+	case errors.Is(err, ErrIllegalTransition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrTransitionForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrVersionConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, ErrReservationExpired):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return nil
+
+}
+
+// List 获取订单列表。普通用户只能查询自己的订单，管理员可以查询所有用户或者指定用户的订单
+func (s *Server) List(ctx context.Context, request *orderPBV1.ListRequest) (*orderPBV1.Response, error) {
+
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	isAdmin, err := s.repo.IsAdmin(ctx, principal)
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "获取订单列表失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "获取订单列表失败")
+	}
+	userID := principal.UserID
+	if isAdmin {
+		userID = request.UserId
+	}
+
+	orders, total, err := s.repo.List(ctx, request, userID)
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "获取订单列表失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "获取订单列表失败")
+	}
+	listResp := &orderPBV1.ListResponse{Total: total}
+	for _, v := range orders {
+		listResp.List = append(listResp.List, orderDetail(v))
+	}
+	pageSize := request.PageSize
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	if n := len(orders); n > 0 && int64(n) >= pageSize {
+		listResp.NextCursor = orders[n-1].ID
+	}
+
+	anyData, err := anypb.New(listResp)
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "获取订单列表失败，错误[3]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &orderPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// orderDetail 转换订单数据
+func orderDetail(order *model.Order) *orderPBV1.OrderDetail {
+	return &orderPBV1.OrderDetail{
+		Id:          order.ID,
+		OrderNo:     order.OrderNo,
+		PaymentType: order.PaymentType,
+		PayStatus:   order.PayStatus,
+		PayTime:     order.PayTime,
+		UserId:      order.UserID,
+		OrderStatus: order.OrderStatus,
+		Amount:      order.Amount,
+		CreateTime:  order.CreateTime,
+		UpdateTime:  order.UpdateTime,
+		Version:     order.Version,
+		Items:       orderItemDetails(order.Items),
+	}
+}
+
+// orderItemDetails 转换订单商品数据
+func orderItemDetails(items []*model.OrderItem) []*orderPBV1.OrderItemDetail {
+
+	details := make([]*orderPBV1.OrderItemDetail, 0, len(items))
+	for _, item := range items {
+		details = append(details, &orderPBV1.OrderItemDetail{
+			Id:        item.ID,
+			ProductId: item.ProductID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+			Subtotal:  item.Subtotal,
+		})
+	}
+	return details
+
+}
+
+// principalFromContext 获取 authservice 传递的调用方身份
+func principalFromContext(ctx context.Context) (*identity.Principal, error) {
+
+	principal, ok := identity.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "未登录")
+	}
+	return principal, nil
+
+}
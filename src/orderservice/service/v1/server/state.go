@@ -0,0 +1,160 @@
+package serverV1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/dtm-labs/dtmgrpc"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	orderPBV1 "orderservice/genproto/go/v1"
+	"orderservice/service/model"
+	productPBV1 "productservice/genproto/go/v1"
+)
+
+var (
+	// ErrIllegalTransition 订单当前状态不能流转到目标状态
+	ErrIllegalTransition = errors.New("订单当前状态不允许该操作")
+	// ErrTransitionForbidden 下单用户没有权限执行该状态流转
+	ErrTransitionForbidden = errors.New("没有权限执行该操作")
+	// ErrVersionConflict 订单已经被其他请求修改
+	ErrVersionConflict = errors.New("订单已被修改，请刷新后重试")
+	// ErrReservationExpired 订单已经超过支付期限或者预留的库存已经释放，订单不能再支付
+	ErrReservationExpired = errors.New("订单已超时，请重新下单")
+)
+
+const (
+	// confirmAttempts 确认预留库存的最大尝试次数
+	confirmAttempts = 3
+	// confirmRetryInterval 确认预留库存临时失败后的重试间隔
+	confirmRetryInterval = 200 * time.Millisecond
+)
+
+// transition 订单状态流转规则
+type transition struct {
+	// to 目标订单状态
+	to orderPBV1.OrderStatus
+	// owner 下单用户是否可以执行。为 false 时只有管理员可以执行
+	owner bool
+}
+
+// transitions 订单状态机。key 为当前订单状态，未列出的流转都不允许
+//
+//	已下单 → 已支付 → 已发货 → 已完成 → 已删除
+//	已下单/已支付 → 已取消 → 已删除
+var transitions = map[orderPBV1.OrderStatus][]transition{
+	orderPBV1.OrderStatus_ORDER_STATUS_NORMAL: {
+		{to: orderPBV1.OrderStatus_ORDER_STATUS_PAID},
+		{to: orderPBV1.OrderStatus_ORDER_STATUS_CANCELLED, owner: true},
+	},
+	orderPBV1.OrderStatus_ORDER_STATUS_PAID: {
+		{to: orderPBV1.OrderStatus_ORDER_STATUS_SHIPPED},
+		{to: orderPBV1.OrderStatus_ORDER_STATUS_CANCELLED},
+	},
+	orderPBV1.OrderStatus_ORDER_STATUS_SHIPPED: {
+		{to: orderPBV1.OrderStatus_ORDER_STATUS_COMPLETED, owner: true},
+	},
+	orderPBV1.OrderStatus_ORDER_STATUS_COMPLETED: {
+		{to: orderPBV1.OrderStatus_ORDER_STATUS_DELETED, owner: true},
+	},
+	orderPBV1.OrderStatus_ORDER_STATUS_CANCELLED: {
+		{to: orderPBV1.OrderStatus_ORDER_STATUS_DELETED, owner: true},
+	},
+	// saga 失败补偿后的订单
+	orderPBV1.OrderStatus_ORDER_STATUS_UNDEFINED: {
+		{to: orderPBV1.OrderStatus_ORDER_STATUS_DELETED, owner: true},
+	},
+}
+
+// checkTransition 检查订单状态是否可以从 from 流转到 to
+func checkTransition(from, to int64, isAdmin bool) error {
+
+	for _, t := range transitions[orderPBV1.OrderStatus(from)] {
+		if int64(t.to) != to {
+			continue
+		}
+		if !t.owner && !isAdmin {
+			return ErrTransitionForbidden
+		}
+		return nil
+	}
+	return ErrIllegalTransition
+
+}
+
+// nextPayStatus 订单状态流转后的支付状态。支付后标记为已支付，已支付的订单取消后标记为已退款
+func nextPayStatus(payStatus, to int64) int64 {
+
+	switch {
+	case to == int64(orderPBV1.OrderStatus_ORDER_STATUS_PAID):
+		return int64(orderPBV1.PayStatus_PAY_STATUS_PIED)
+	case to == int64(orderPBV1.OrderStatus_ORDER_STATUS_CANCELLED) &&
+		payStatus == int64(orderPBV1.PayStatus_PAY_STATUS_PIED):
+		return int64(orderPBV1.PayStatus_PAY_STATUS_REFUNDED)
+	}
+	return payStatus
+
+}
+
+// confirmReservation 订单已经修改为已支付后确认预留库存，临时失败时重试。
+// 预留已经过期或者重试后仍然失败时取消订单，由取消流程释放库存并退款
+func (r *Repository) confirmReservation(ctx context.Context, order *model.Order) error {
+
+	request := &productPBV1.ReservationRequest{OrderNo: order.OrderNo}
+	var err error
+	for i := 0; i < confirmAttempts; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(confirmRetryInterval):
+			}
+		}
+		_, err = r.product.ConfirmReservation(ctx, request)
+		if err == nil || status.Code(err) == codes.Aborted {
+			break
+		}
+	}
+	if err == nil {
+		return nil
+	}
+
+	cancelled := int64(orderPBV1.OrderStatus_ORDER_STATUS_CANCELLED)
+	if _, cancelErr := r.transition(ctx, order.ID, order.Version, cancelled, 0, true, "确认预留库存失败，自动取消"); cancelErr != nil {
+		return errors.New("确认预留库存失败：" + err.Error() + "，取消订单失败：" + cancelErr.Error())
+	}
+	if status.Code(err) == codes.Aborted {
+		return ErrReservationExpired
+	}
+	return err
+
+}
+
+// cancelWithRelease 在 dtm 二阶段消息中执行取消订单的本地事务 claim。
+// 本地事务与消息一起提交，提交后由 dtm 调用产品服务释放预留库存，失败时一直重试直到成功
+func (r *Repository) cancelWithRelease(ctx context.Context, orderNo string, claim func(tx *gorm.DB) error) error {
+
+	db, err := r.mysqlDB.DB()
+	if err != nil {
+		return err
+	}
+	sagaConf := r.conf.Saga
+	msg := dtmgrpc.NewMsgGrpc(sagaConf.DtmServer, uuid.NewString())
+	msg.Add(
+		sagaBranch(sagaConf.ProductTarget, productPBV1.ProductService_CancelReservation_FullMethodName),
+		&productPBV1.ReservationRequest{OrderNo: orderNo},
+	)
+	return msg.DoAndSubmitDB(
+		sagaBranch(sagaConf.OrderTarget, orderPBV1.OrderService_QueryPrepared_FullMethodName),
+		db,
+		func(tx *sql.Tx) error {
+			return claim(r.gormTx(ctx, tx))
+		},
+	)
+
+}
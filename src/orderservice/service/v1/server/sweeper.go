@@ -0,0 +1,68 @@
+package serverV1
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+
+	"orderservice/config"
+)
+
+// OrderSweeper 定时取消超过支付期限的订单并释放预留库存
+type OrderSweeper struct {
+	conf   *config.Config
+	logger log.Logger
+	repo   *Repository
+}
+
+// NewOrderSweeper 实例化 OrderSweeper
+func NewOrderSweeper(
+	conf *config.Config,
+	logger log.Logger,
+	repo *Repository,
+) *OrderSweeper {
+	return &OrderSweeper{
+		conf:   conf,
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+// Run 每隔 conf.Payment.SweepInterval 取消一次超时订单，直到 ctx 取消
+func (s *OrderSweeper) Run(ctx context.Context) error {
+
+	ticker := time.NewTicker(s.conf.Payment.SweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			s.sweep(ctx)
+		}
+	}
+
+}
+
+// sweep 分批取消超时订单。一批取消满时继续下一批，直到没有超时订单
+func (s *OrderSweeper) sweep(ctx context.Context) {
+
+	for ctx.Err() == nil {
+		cancelled, err := s.repo.CancelExpiredOrders(ctx, s.conf.Payment.SweepBatch)
+		if err != nil {
+			_ = level.Error(s.logger).Log("msg", "取消超时订单失败，错误[1]："+err.Error())
+			return
+		}
+		if cancelled > 0 {
+			_ = level.Info(s.logger).Log("msg", "取消超时订单："+strconv.Itoa(cancelled))
+		}
+		if cancelled < s.conf.Payment.SweepBatch {
+			return
+		}
+	}
+
+}
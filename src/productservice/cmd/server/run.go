@@ -19,6 +19,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"gorm.io/gorm"
 
+	"authservice/pkg/identity"
 	"productservice/config"
 	productPBV1 "productservice/genproto/go/v1"
 	"productservice/service/model"
@@ -34,6 +35,7 @@ type Server struct {
 	grpcServer *grpc.Server
 	mysqlDB    *gorm.DB
 	repo       *serverV1.Repository
+	sweeper    *serverV1.ReservationSweeper
 	trace      *sdktrace.TracerProvider
 }
 
@@ -46,6 +48,7 @@ func NewServer(
 	grpcServer *grpc.Server,
 	mysqlDB *gorm.DB,
 	repo *serverV1.Repository,
+	sweeper *serverV1.ReservationSweeper,
 	trace *sdktrace.TracerProvider,
 ) *Server {
 	return &Server{
@@ -56,6 +59,7 @@ func NewServer(
 		grpcServer: grpcServer,
 		mysqlDB:    mysqlDB,
 		repo:       repo,
+		sweeper:    sweeper,
 		trace:      trace,
 	}
 }
@@ -67,10 +71,14 @@ func NewGrpcServer(productServerV1 productPBV1.ProductServiceServer) *grpc.Serve
 		grpc.ChainStreamInterceptor(
 			// otel 链路追踪
 			otelgrpc.StreamServerInterceptor(),
+			// 加载 authservice 注入的调用方身份
+			identity.StreamServerInterceptor(),
 		),
 		grpc.ChainUnaryInterceptor(
 			// otel 链路追踪
 			otelgrpc.UnaryServerInterceptor(),
+			// 加载 authservice 注入的调用方身份
+			identity.UnaryServerInterceptor(),
 			// PGV 中间件
 			Jgrpc_pgv_interceptor.ValidationUnaryInterceptor,
 		),
@@ -87,6 +95,7 @@ func NewHttpServer(conf *config.Config) *http.Server {
 		runtime.WithErrorHandler(Jgrpc_response.HttpErrorHandler),
 		runtime.WithForwardResponseOption(Jgrpc_response.HttpSuccessResponseModifier),
 		runtime.WithMarshalerOption("*", &Jgrpc_response.CustomMarshaller{}),
+		runtime.WithIncomingHeaderMatcher(identity.HeaderMatcher(runtime.DefaultHeaderMatcher)),
 	)
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -135,6 +144,17 @@ func (s *Server) RunServer() {
 			_ = level.Error(s.logger).Log("msg", "failed to stop web server", "err", err)
 		}
 	})
+
+	// 启动过期预留库存释放任务
+	sweepCtx, sweepCancel := context.WithCancel(context.Background())
+	s.runGroup.Add(func() error {
+
+		_ = level.Info(s.logger).Log("msg", "starting reservation sweeper", "interval", s.conf.Reservation.SweepInterval)
+		return s.sweeper.Run(sweepCtx)
+
+	}, func(err error) {
+		sweepCancel()
+	})
 	s.runGroup.Add(run.SignalHandler(context.Background(), syscall.SIGINT, syscall.SIGTERM))
 
 	if err := s.runGroup.Run(); err != nil {
@@ -23,6 +23,7 @@ func InitServer(cfg string) (*Server, error) {
 		// 实例化服务
 		serverV1.NewServer,
 		serverV1.NewRepository,
+		serverV1.NewReservationSweeper,
 
 		// 组件
 		NewMysqlDB,
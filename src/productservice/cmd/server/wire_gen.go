@@ -28,6 +28,7 @@ func InitServer(cfg string) (*Server, error) {
 	repository := serverV1.NewRepository(db, configConfig, otelSpan)
 	productServiceServer := serverV1.NewServer(logger, repository)
 	grpcServer := NewGrpcServer(productServiceServer)
-	serverServer := NewServer(configConfig, group, logger, server, grpcServer, db, repository, tracerProvider)
+	reservationSweeper := serverV1.NewReservationSweeper(configConfig, logger, repository)
+	serverServer := NewServer(configConfig, group, logger, server, grpcServer, db, repository, reservationSweeper, tracerProvider)
 	return serverServer, nil
 }
@@ -6,11 +6,12 @@ import (
 
 // Config Service config
 type Config struct {
-	Grpc     Grpc     `json:"grpc" yaml:"grpc"`
-	Http     Http     `json:"http" yaml:"http"`
-	Database Database `json:"database" yaml:"database"`
-	Client   Client   `json:"client" yaml:"client"`
-	Trace    Trace    `json:"trace" yaml:"trace"`
+	Grpc        Grpc        `json:"grpc" yaml:"grpc"`
+	Http        Http        `json:"http" yaml:"http"`
+	Database    Database    `json:"database" yaml:"database"`
+	Client      Client      `json:"client" yaml:"client"`
+	Reservation Reservation `json:"reservation" yaml:"reservation"`
+	Trace       Trace       `json:"trace" yaml:"trace"`
 }
 
 // NewConfig Initial service's config
@@ -21,6 +22,7 @@ func NewConfig(cfg string) *Config {
 	}
 
 	viper.SetConfigFile(cfg)
+	setReservationDefaults()
 
 	// Read config file
 	if err := viper.ReadInConfig(); err != nil {
@@ -31,6 +33,9 @@ func NewConfig(cfg string) *Config {
 	if err := viper.Unmarshal(conf); err != nil {
 		panic("assign config failed.[ERROR]=>" + err.Error())
 	}
+	if err := conf.Reservation.validate(); err != nil {
+		panic("invalid config.[ERROR]=>" + err.Error())
+	}
 
 	return conf
 
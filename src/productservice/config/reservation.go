@@ -0,0 +1,41 @@
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Reservation 库存预留配置
+type Reservation struct {
+	// 预留有效期。超过有效期还没有确认的预留由后台任务释放。
+	// 需要大于订单服务的 payment.timeout，订单服务先取消超时订单，这里只作为兜底
+	TTL time.Duration `json:"ttl" yaml:"ttl"`
+	// 后台任务扫描过期预留的间隔
+	SweepInterval time.Duration `json:"sweepInterval" yaml:"sweepInterval"`
+	// 后台任务每次最多释放的预留数量
+	SweepBatch int `json:"sweepBatch" yaml:"sweepBatch"`
+}
+
+// setReservationDefaults 库存预留配置默认值
+func setReservationDefaults() {
+	viper.SetDefault("reservation.ttl", 30*time.Minute)
+	viper.SetDefault("reservation.sweepInterval", time.Minute)
+	viper.SetDefault("reservation.sweepBatch", 100)
+}
+
+// validate 检查库存预留配置
+func (r Reservation) validate() error {
+
+	switch {
+	case r.TTL <= 0:
+		return errors.New("reservation.ttl must be greater than 0")
+	case r.SweepInterval <= 0:
+		return errors.New("reservation.sweepInterval must be greater than 0")
+	case r.SweepBatch <= 0:
+		return errors.New("reservation.sweepBatch must be greater than 0")
+	}
+	return nil
+
+}
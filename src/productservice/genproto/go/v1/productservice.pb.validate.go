@@ -958,6 +958,359 @@ var _ interface {
 	ErrorName() string
 } = DecreaseStockRequestValidationError{}
 
+// Validate checks the field values on ReserveStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReserveStockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReserveStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReserveStockRequestMultiError, or nil if none found.
+func (m *ReserveStockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReserveStockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetOrderNo()); l < 1 || l > 64 {
+		err := ReserveStockRequestValidationError{
+			field:  "OrderNo",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetId() < 1 {
+		err := ReserveStockRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() < 1 {
+		err := ReserveStockRequestValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReserveStockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReserveStockRequestMultiError is an error wrapping multiple validation
+// errors returned by ReserveStockRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReserveStockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReserveStockRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReserveStockRequestMultiError) AllErrors() []error { return m }
+
+// ReserveStockRequestValidationError is the validation error returned by
+// ReserveStockRequest.Validate if the designated constraints aren't met.
+type ReserveStockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReserveStockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReserveStockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReserveStockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReserveStockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReserveStockRequestValidationError) ErrorName() string {
+	return "ReserveStockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReserveStockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReserveStockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReserveStockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReserveStockRequestValidationError{}
+
+// Validate checks the field values on ReservationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReservationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReservationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReservationRequestMultiError, or nil if none found.
+func (m *ReservationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReservationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetOrderNo()); l < 1 || l > 64 {
+		err := ReservationRequestValidationError{
+			field:  "OrderNo",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReservationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReservationRequestMultiError is an error wrapping multiple validation errors
+// returned by ReservationRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReservationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReservationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReservationRequestMultiError) AllErrors() []error { return m }
+
+// ReservationRequestValidationError is the validation error returned by
+// ReservationRequest.Validate if the designated constraints aren't met.
+type ReservationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReservationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReservationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReservationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReservationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReservationRequestValidationError) ErrorName() string {
+	return "ReservationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReservationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReservationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReservationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReservationRequestValidationError{}
+
+// Validate checks the field values on StockDetail with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StockDetail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockDetail with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StockDetailMultiError, or
+// nil if none found.
+func (m *StockDetail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockDetail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Available
+
+	// no validation rules for Reserved
+
+	if len(errors) > 0 {
+		return StockDetailMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockDetailMultiError is an error wrapping multiple validation errors
+// returned by StockDetail.ValidateAll() if the designated constraints aren't met.
+type StockDetailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockDetailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockDetailMultiError) AllErrors() []error { return m }
+
+// StockDetailValidationError is the validation error returned by
+// StockDetail.Validate if the designated constraints aren't met.
+type StockDetailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockDetailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockDetailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockDetailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockDetailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockDetailValidationError) ErrorName() string { return "StockDetailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StockDetailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockDetail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockDetailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockDetailValidationError{}
+
 // Validate checks the field values on ProductDetail with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -26,6 +26,11 @@ const (
 	ProductService_List_FullMethodName                = "/proto.product.v1.ProductService/List"
 	ProductService_DecreaseStock_FullMethodName       = "/proto.product.v1.ProductService/DecreaseStock"
 	ProductService_DecreaseStockRevert_FullMethodName = "/proto.product.v1.ProductService/DecreaseStockRevert"
+	ProductService_ReserveStock_FullMethodName        = "/proto.product.v1.ProductService/ReserveStock"
+	ProductService_ReserveStockRevert_FullMethodName  = "/proto.product.v1.ProductService/ReserveStockRevert"
+	ProductService_ConfirmReservation_FullMethodName  = "/proto.product.v1.ProductService/ConfirmReservation"
+	ProductService_CancelReservation_FullMethodName   = "/proto.product.v1.ProductService/CancelReservation"
+	ProductService_StockInfo_FullMethodName           = "/proto.product.v1.ProductService/StockInfo"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -39,6 +44,11 @@ type ProductServiceClient interface {
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*Response, error)
 	DecreaseStock(ctx context.Context, in *DecreaseStockRequest, opts ...grpc.CallOption) (*Response, error)
 	DecreaseStockRevert(ctx context.Context, in *DecreaseStockRequest, opts ...grpc.CallOption) (*Response, error)
+	ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*Response, error)
+	ReserveStockRevert(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*Response, error)
+	ConfirmReservation(ctx context.Context, in *ReservationRequest, opts ...grpc.CallOption) (*Response, error)
+	CancelReservation(ctx context.Context, in *ReservationRequest, opts ...grpc.CallOption) (*Response, error)
+	StockInfo(ctx context.Context, in *DetailRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type productServiceClient struct {
@@ -112,6 +122,51 @@ func (c *productServiceClient) DecreaseStockRevert(ctx context.Context, in *Decr
 	return out, nil
 }
 
+func (c *productServiceClient) ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, ProductService_ReserveStock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReserveStockRevert(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, ProductService_ReserveStockRevert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ConfirmReservation(ctx context.Context, in *ReservationRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, ProductService_ConfirmReservation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CancelReservation(ctx context.Context, in *ReservationRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, ProductService_CancelReservation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) StockInfo(ctx context.Context, in *DetailRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, ProductService_StockInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility
@@ -123,6 +178,11 @@ type ProductServiceServer interface {
 	List(context.Context, *ListRequest) (*Response, error)
 	DecreaseStock(context.Context, *DecreaseStockRequest) (*Response, error)
 	DecreaseStockRevert(context.Context, *DecreaseStockRequest) (*Response, error)
+	ReserveStock(context.Context, *ReserveStockRequest) (*Response, error)
+	ReserveStockRevert(context.Context, *ReserveStockRequest) (*Response, error)
+	ConfirmReservation(context.Context, *ReservationRequest) (*Response, error)
+	CancelReservation(context.Context, *ReservationRequest) (*Response, error)
+	StockInfo(context.Context, *DetailRequest) (*Response, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -151,6 +211,21 @@ func (UnimplementedProductServiceServer) DecreaseStock(context.Context, *Decreas
 func (UnimplementedProductServiceServer) DecreaseStockRevert(context.Context, *DecreaseStockRequest) (*Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DecreaseStockRevert not implemented")
 }
+func (UnimplementedProductServiceServer) ReserveStock(context.Context, *ReserveStockRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveStock not implemented")
+}
+func (UnimplementedProductServiceServer) ReserveStockRevert(context.Context, *ReserveStockRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveStockRevert not implemented")
+}
+func (UnimplementedProductServiceServer) ConfirmReservation(context.Context, *ReservationRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmReservation not implemented")
+}
+func (UnimplementedProductServiceServer) CancelReservation(context.Context, *ReservationRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReservation not implemented")
+}
+func (UnimplementedProductServiceServer) StockInfo(context.Context, *DetailRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StockInfo not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 
 // UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -290,6 +365,96 @@ func _ProductService_DecreaseStockRevert_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_ReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReserveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReserveStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReserveStock(ctx, req.(*ReserveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReserveStockRevert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReserveStockRevert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReserveStockRevert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReserveStockRevert(ctx, req.(*ReserveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ConfirmReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ConfirmReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ConfirmReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ConfirmReservation(ctx, req.(*ReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CancelReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CancelReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CancelReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CancelReservation(ctx, req.(*ReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_StockInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).StockInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_StockInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).StockInfo(ctx, req.(*DetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -325,6 +490,26 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DecreaseStockRevert",
 			Handler:    _ProductService_DecreaseStockRevert_Handler,
 		},
+		{
+			MethodName: "ReserveStock",
+			Handler:    _ProductService_ReserveStock_Handler,
+		},
+		{
+			MethodName: "ReserveStockRevert",
+			Handler:    _ProductService_ReserveStockRevert_Handler,
+		},
+		{
+			MethodName: "ConfirmReservation",
+			Handler:    _ProductService_ConfirmReservation_Handler,
+		},
+		{
+			MethodName: "CancelReservation",
+			Handler:    _ProductService_CancelReservation_Handler,
+		},
+		{
+			MethodName: "StockInfo",
+			Handler:    _ProductService_StockInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/productservice.proto",
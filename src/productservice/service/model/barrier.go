@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// MigrateBarrierTable 迁移 barrier 表
+func MigrateBarrierTable(db *gorm.DB) {
+
+	m := db.Migrator()
+	if !m.HasTable(&Barrier{}) {
+		if err := m.CreateTable(&Barrier{}); err != nil {
+			panic("migrate Failed.[ERROR]=>create barrier table failed.")
+		}
+		db.Exec("ALTER TABLE `barrier` COMMENT 'dtm sub-transaction barrier table'")
+	}
+
+}
+
+// Barrier dtm 子事务屏障表。与业务数据在同一个本地事务中写入，用于过滤重复请求、空补偿以及悬挂请求
+type Barrier struct {
+	// 主键 ID
+	ID int64 `json:"id" gorm:"column:id;primaryKey;type:bigint(22);autoIncrement;comment:主键id"`
+	// 事务类型
+	TransType string `json:"trans_type" gorm:"column:trans_type;type:varchar(45);default:'';comment:事务类型"`
+	// 全局事务 ID / 分支 ID / 分支操作 / 屏障 ID
+	Gid       string `json:"gid" gorm:"column:gid;uniqueIndex:uniq_barrier,priority:1;type:varchar(128);default:'';comment:全局事务id"`
+	BranchID  string `json:"branch_id" gorm:"column:branch_id;uniqueIndex:uniq_barrier,priority:2;type:varchar(128);default:'';comment:分支id"`
+	Op        string `json:"op" gorm:"column:op;uniqueIndex:uniq_barrier,priority:3;type:varchar(45);default:'';comment:分支操作"`
+	BarrierID string `json:"barrier_id" gorm:"column:barrier_id;uniqueIndex:uniq_barrier,priority:4;type:varchar(45);default:'';comment:屏障id"`
+	// 写入该记录的分支操作
+	Reason string `json:"reason" gorm:"column:reason;type:varchar(45);default:'';comment:写入该记录的分支操作"`
+	// 添加时间 / 更新时间
+	CreateTime time.Time `json:"create_time" gorm:"column:create_time;index;type:datetime;default:CURRENT_TIMESTAMP;comment:create time"`
+	UpdateTime time.Time `json:"update_time" gorm:"column:update_time;index;type:datetime;default:CURRENT_TIMESTAMP;comment:update time"`
+}
+
+// TableName 表名称
+func (*Barrier) TableName() string {
+	return "barrier"
+}
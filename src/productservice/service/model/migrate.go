@@ -7,4 +7,6 @@ import (
 // Migrate 迁移表格
 func Migrate(db *gorm.DB) {
 	MigrateProductTable(db) // Migrate user table
+	MigrateBarrierTable(db)
+	MigrateStockReservationTable(db)
 }
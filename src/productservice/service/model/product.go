@@ -15,6 +15,15 @@ func MigrateProductTable(db *gorm.DB) {
 		db.Exec("ALTER TABLE `product` COMMENT 'product table'")
 	}
 
+	// 补充后续新增的字段
+	for _, column := range []string{"Reserved"} {
+		if !m.HasColumn(&Product{}, column) {
+			if err := m.AddColumn(&Product{}, column); err != nil {
+				panic("migrate Failed.[ERROR]=>add product column " + column + " failed.")
+			}
+		}
+	}
+
 }
 
 // Product Product Table
@@ -29,8 +38,10 @@ type Product struct {
 	Desc string `json:"desc" gorm:"column:desc;type:varchar(255);default:'';not null;comment:产品简介"`
 	// 产品标题
 	Title string `json:"title" gorm:"column:title;type:varchar(100);default:'';not null;comment:产品标题"`
-	// 产品库存
+	// 产品库存。可以售卖的数量，不包含已经预留的库存
 	Stock int64 `json:"stock" gorm:"column:stock;type:int(10);default:0;not null;comment:产品库存"`
+	// 已预留还没有确认的库存
+	Reserved int64 `json:"reserved" gorm:"column:reserved;type:int(10);default:0;not null;comment:预留库存"`
 	// 是否禁用
 	IsDisable int64 `json:"is_disable" gorm:"column:is_disable;type:tinyint(2);default:2;not null;comment:是否禁用[1=是2=否]"`
 	// 添加时间 / 更新时间
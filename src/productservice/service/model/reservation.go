@@ -0,0 +1,51 @@
+package model
+
+import (
+	"gorm.io/gorm"
+)
+
+// 库存预留状态
+const (
+	// ReservationReserved 已预留，等待订单支付后确认
+	ReservationReserved int64 = 1
+	// ReservationConfirmed 订单已支付，预留的库存已经售出
+	ReservationConfirmed int64 = 2
+	// ReservationReleased 已释放，库存已经归还
+	ReservationReleased int64 = 3
+)
+
+// MigrateStockReservationTable 迁移 stock_reservation 表
+func MigrateStockReservationTable(db *gorm.DB) {
+
+	m := db.Migrator()
+	if !m.HasTable(&StockReservation{}) {
+		if err := m.CreateTable(&StockReservation{}); err != nil {
+			panic("migrate Failed.[ERROR]=>create stock_reservation table failed.")
+		}
+		db.Exec("ALTER TABLE `stock_reservation` COMMENT 'stock reservation table'")
+	}
+
+}
+
+// StockReservation 库存预留表。下单时预留库存，支付后确认，取消或者过期后释放
+type StockReservation struct {
+	// 主键 ID
+	ID int64 `json:"id" gorm:"column:id;primaryKey;type:int(10);unique;autoIncrement;comment:主键id"`
+	// 订单编号 / 产品 ID。同一个订单的同一个产品只有一条预留
+	OrderNo   string `json:"order_no" gorm:"column:order_no;uniqueIndex:uniq_order_product,priority:1;type:varchar(64);default:'';not null;comment:订单编号"`
+	ProductID int64  `json:"product_id" gorm:"column:product_id;uniqueIndex:uniq_order_product,priority:2;type:int(10);default:0;not null;comment:产品id"`
+	// 预留数量
+	Quantity int64 `json:"quantity" gorm:"column:quantity;type:int(10);default:0;not null;comment:预留数量"`
+	// 预留状态
+	Status int64 `json:"status" gorm:"column:status;index:idx_status_expire,priority:1;type:tinyint(2);default:1;not null;comment:预留状态[1=已预留2=已确认3=已释放]"`
+	// 过期时间。超过该时间还没有确认的预留会被释放
+	ExpireTime int64 `json:"expire_time" gorm:"column:expire_time;index:idx_status_expire,priority:2;type:int(10);default:0;not null;comment:过期时间"`
+	// 添加时间 / 更新时间
+	CreateTime int64 `json:"create_time" gorm:"column:create_time;type:int(10);default:0;comment:create time'"`
+	UpdateTime int64 `json:"update_time" gorm:"column:update_time;type:int(10);default:0;comment:update time"`
+}
+
+// TableName 表名称
+func (*StockReservation) TableName() string {
+	return "stock_reservation"
+}
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"authservice/pkg/identity"
 	"productservice/config"
 	productPBV1 "productservice/genproto/go/v1"
 )
@@ -21,8 +22,8 @@ func NewProductClient(conf *config.Config) (productPBV1.ProductServiceClient, er
 	conn, err := grpc.DialContext(
 		ctx, serverAddress,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
-		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor(), identity.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor(), identity.StreamClientInterceptor()),
 	)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,43 @@
+package serverV1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/dtm-labs/dtmgrpc"
+	"gorm.io/gorm"
+
+	"productservice/service/model"
+)
+
+// callWithBarrier 在同一个本地事务中写入 dtm 子事务屏障并执行 fn。
+// 重复请求、空补偿以及悬挂请求只写入屏障，不执行 fn，直接返回成功。
+// 不是由 dtm 发起的请求没有事务信息，只在本地事务中执行 fn
+func (r *Repository) callWithBarrier(ctx context.Context, fn func(tx *gorm.DB) error) error {
+
+	tx := r.mysqlDB.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+	barrier, err := dtmgrpc.BarrierFromGrpc(ctx)
+	if err != nil {
+		if err = fn(tx); err != nil {
+			tx.Rollback()
+			return err
+		}
+		return tx.Commit().Error
+	}
+
+	sqlTx, ok := tx.Statement.ConnPool.(*sql.Tx)
+	if !ok {
+		tx.Rollback()
+		return errors.New("获取本地事务失败")
+	}
+	barrier.BarrierTableName = (&model.Barrier{}).TableName()
+	// Call 负责提交或者回滚本地事务
+	return barrier.Call(sqlTx, func(*sql.Tx) error {
+		return fn(tx)
+	})
+
+}
@@ -3,14 +3,23 @@ package serverV1
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"time"
+
 	Jgrpc_otelspan "github.com/janrs-io/Jgrpc-otel-span"
 	"google.golang.org/protobuf/encoding/protojson"
 	"gorm.io/gorm"
+
 	"productservice/config"
 	productPBV1 "productservice/genproto/go/v1"
 	"productservice/service/model"
-	"strconv"
-	"time"
+)
+
+var (
+	// ErrOutOfStock 库存不足
+	ErrOutOfStock = errors.New("产品库存不足")
+	// ErrProductNotFound 产品不存在
+	ErrProductNotFound = errors.New("产品不存在")
 )
 
 // Repository 数据仓库层
@@ -130,32 +139,54 @@ func (r *Repository) List(ctx context.Context, request *productPBV1.ListRequest)
 
 }
 
-// DecreaseStock 减少库存
+// DecreaseStock 减少库存。saga 分支接口，通过子事务屏障保证幂等
+// 只有库存足够时才会扣减，库存不足时返回 ErrOutOfStock，产品不存在时返回 ErrProductNotFound
 func (r *Repository) DecreaseStock(ctx context.Context, productId int64, quantity int64) error {
 
 	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
 	defer span.End()
 
-	if err := r.ProductModel().
-		Where("id = ?", productId).
-		Update("stock", gorm.Expr("stock -"+strconv.FormatInt(quantity, 10))).
-		Error; err != nil {
-		return r.span.Error(span, err.Error())
+	err := r.callWithBarrier(ctx, func(tx *gorm.DB) error {
+		table := (&model.Product{}).TableName()
+		result := tx.Table(table).
+			Where("id = ? AND stock >= ?", productId, quantity).
+			Update("stock", gorm.Expr("stock - ?", quantity))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected > 0 {
+			return nil
+		}
+		var count int64
+		if err := tx.Table(table).Where("id = ?", productId).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return ErrProductNotFound
+		}
+		return ErrOutOfStock
+	})
+	if err != nil {
+		_ = r.span.Error(span, err.Error())
+		return err
 	}
 	return nil
 
 }
 
-// IncreaseStock 增加库存
+// IncreaseStock 增加库存。减少库存的补偿接口，通过子事务屏障保证幂等并且忽略空补偿
 func (r *Repository) IncreaseStock(ctx context.Context, productId int64, quantity int64) error {
 
 	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
 	defer span.End()
 
-	if err := r.ProductModel().
-		Where("id = ?", productId).
-		Update("stock", gorm.Expr("stock + "+strconv.FormatInt(quantity, 10))).
-		Error; err != nil {
+	err := r.callWithBarrier(ctx, func(tx *gorm.DB) error {
+		return tx.Table((&model.Product{}).TableName()).
+			Where("id = ?", productId).
+			Update("stock", gorm.Expr("stock + ?", quantity)).
+			Error
+	})
+	if err != nil {
 		return r.span.Error(span, err.Error())
 	}
 	return nil
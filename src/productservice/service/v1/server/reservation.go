@@ -0,0 +1,269 @@
+package serverV1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"productservice/service/model"
+)
+
+// ErrReservationExpired 预留库存已过期或者已释放，订单不能再确认
+var ErrReservationExpired = errors.New("预留库存已过期，请重新下单")
+
+// ReservationModel 获取 stock_reservation 模型
+func (r *Repository) ReservationModel() *gorm.DB {
+	reservationModel := &model.StockReservation{}
+	return r.mysqlDB.Table(reservationModel.TableName())
+}
+
+// ReserveStock 预留库存。saga 分支接口，通过子事务屏障保证幂等
+// 从可售库存中扣减并计入预留库存，预留在 conf.Reservation.TTL 后过期。
+// 库存不足时返回 ErrOutOfStock，产品不存在时返回 ErrProductNotFound
+func (r *Repository) ReserveStock(ctx context.Context, orderNo string, productId int64, quantity int64) error {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	err := r.callWithBarrier(ctx, func(tx *gorm.DB) error {
+		table := (&model.Product{}).TableName()
+		result := tx.Table(table).
+			Where("id = ? AND stock >= ?", productId, quantity).
+			Updates(map[string]any{
+				"stock":    gorm.Expr("stock - ?", quantity),
+				"reserved": gorm.Expr("reserved + ?", quantity),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			var count int64
+			if err := tx.Table(table).Where("id = ?", productId).Count(&count).Error; err != nil {
+				return err
+			}
+			if count == 0 {
+				return ErrProductNotFound
+			}
+			return ErrOutOfStock
+		}
+
+		now := time.Now()
+		reservation := &model.StockReservation{
+			OrderNo:    orderNo,
+			ProductID:  productId,
+			Quantity:   quantity,
+			Status:     model.ReservationReserved,
+			ExpireTime: now.Add(r.conf.Reservation.TTL).Unix(),
+			CreateTime: now.Unix(),
+			UpdateTime: now.Unix(),
+		}
+		return tx.Table(reservation.TableName()).Create(reservation).Error
+	})
+	if err != nil {
+		_ = r.span.Error(span, err.Error())
+		return err
+	}
+	return nil
+
+}
+
+// ReleaseReservedStock 释放订单预留的产品库存。预留库存的补偿接口，通过子事务屏障保证幂等并且忽略空补偿
+func (r *Repository) ReleaseReservedStock(ctx context.Context, orderNo string, productId int64) error {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	err := r.callWithBarrier(ctx, func(tx *gorm.DB) error {
+		reservations, err := lockReservations(tx, "order_no = ? AND product_id = ?", orderNo, productId)
+		if err != nil {
+			return err
+		}
+		for i := range reservations {
+			if err = releaseReservation(tx, &reservations[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	return nil
+
+}
+
+// ConfirmReservation 订单支付后确认订单的全部预留。确认后预留的库存视为已经售出
+// 重复确认直接返回成功。任意一条预留已经过期或者已经释放时返回 ErrReservationExpired
+func (r *Repository) ConfirmReservation(ctx context.Context, orderNo string) error {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	err := r.mysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		reservations, err := lockReservations(tx, "order_no = ?", orderNo)
+		if err != nil {
+			return err
+		}
+		if len(reservations) == 0 {
+			return ErrReservationExpired
+		}
+
+		now := time.Now().Unix()
+		for _, reservation := range reservations {
+			switch {
+			case reservation.Status == model.ReservationConfirmed:
+				continue
+			case reservation.Status != model.ReservationReserved || reservation.ExpireTime <= now:
+				return ErrReservationExpired
+			}
+			err = tx.Table(reservation.TableName()).
+				Where("id = ?", reservation.ID).
+				Updates(map[string]any{
+					"status":      model.ReservationConfirmed,
+					"update_time": now,
+				}).Error
+			if err != nil {
+				return err
+			}
+			err = tx.Table((&model.Product{}).TableName()).
+				Where("id = ?", reservation.ProductID).
+				Update("reserved", gorm.Expr("reserved - ?", reservation.Quantity)).
+				Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		_ = r.span.Error(span, err.Error())
+		return err
+	}
+	return nil
+
+}
+
+// CancelReservation 订单取消后释放订单的全部预留，已确认的预留同样归还库存。重复取消直接返回成功
+func (r *Repository) CancelReservation(ctx context.Context, orderNo string) error {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	err := r.mysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		reservations, err := lockReservations(tx, "order_no = ?", orderNo)
+		if err != nil {
+			return err
+		}
+		for i := range reservations {
+			if err = releaseReservation(tx, &reservations[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	return nil
+
+}
+
+// ReleaseExpiredReservations 释放最多 limit 条已经过期还没有确认的预留，返回释放的数量
+func (r *Repository) ReleaseExpiredReservations(ctx context.Context, limit int) (int, error) {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	var reservations []model.StockReservation
+	err := r.ReservationModel().WithContext(ctx).
+		Where("status = ? AND expire_time <= ?", model.ReservationReserved, time.Now().Unix()).
+		Order("expire_time ASC").
+		Limit(limit).
+		Find(&reservations).Error
+	if err != nil {
+		return 0, r.span.Error(span, err.Error())
+	}
+
+	released := 0
+	for i := range reservations {
+		// 每条预留使用单独的事务并重新加锁读取，期间已经被确认或者释放的预留直接跳过
+		err = r.mysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			locked, err := lockReservations(tx, "id = ? AND status = ? AND expire_time <= ?",
+				reservations[i].ID, model.ReservationReserved, time.Now().Unix())
+			if err != nil {
+				return err
+			}
+			for j := range locked {
+				if err = releaseReservation(tx, &locked[j]); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return released, r.span.Error(span, err.Error())
+		}
+		released++
+	}
+	return released, nil
+
+}
+
+// StockInfo 获取产品可售以及预留库存
+func (r *Repository) StockInfo(ctx context.Context, productId int64) (*model.Product, error) {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	product := &model.Product{}
+	if err := r.ProductModel().Select("id", "stock", "reserved").Take(product, productId).Error; err != nil {
+		// 返回原始错误，调用方通过 gorm.ErrRecordNotFound 判断产品是否存在
+		_ = r.span.Error(span, err.Error())
+		return nil, err
+	}
+	return product, nil
+
+}
+
+// lockReservations 在事务 tx 中加锁读取预留。确认、取消以及过期释放都先加锁，避免同时修改同一条预留
+func lockReservations(tx *gorm.DB, query string, args ...any) ([]model.StockReservation, error) {
+
+	var reservations []model.StockReservation
+	err := tx.Table((&model.StockReservation{}).TableName()).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(query, args...).
+		Find(&reservations).Error
+	return reservations, err
+
+}
+
+// releaseReservation 在事务 tx 中释放已经加锁的预留并归还库存。已经释放的预留直接返回
+func releaseReservation(tx *gorm.DB, reservation *model.StockReservation) error {
+
+	if reservation.Status == model.ReservationReleased {
+		return nil
+	}
+	err := tx.Table(reservation.TableName()).
+		Where("id = ?", reservation.ID).
+		Updates(map[string]any{
+			"status":      model.ReservationReleased,
+			"update_time": time.Now().Unix(),
+		}).Error
+	if err != nil {
+		return err
+	}
+
+	// 已确认的预留已经从预留库存中扣除，只需要归还可售库存
+	stock := map[string]any{"stock": gorm.Expr("stock + ?", reservation.Quantity)}
+	if reservation.Status == model.ReservationReserved {
+		stock["reserved"] = gorm.Expr("reserved - ?", reservation.Quantity)
+	}
+	return tx.Table((&model.Product{}).TableName()).
+		Where("id = ?", reservation.ProductID).
+		Updates(stock).
+		Error
+
+}
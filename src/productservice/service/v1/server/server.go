@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -142,8 +143,15 @@ func (s *Server) Update(ctx context.Context, request *productPBV1.UpdateRequest)
 func (s *Server) DecreaseStock(ctx context.Context, request *productPBV1.DecreaseStockRequest) (*productPBV1.Response, error) {
 
 	if err := s.repo.DecreaseStock(ctx, request.Id, request.Quantity); err != nil {
+		switch {
+		case errors.Is(err, ErrOutOfStock):
+			return nil, businessError(err, productPBV1.ErrorReason_ERROR_REASON_OUT_OF_STOCK)
+		case errors.Is(err, ErrProductNotFound):
+			return nil, businessError(err, productPBV1.ErrorReason_ERROR_REASON_PRODUCT_NOT_FOUND)
+		}
+		// 其他错误由 dtm 重试
 		_ = level.Error(s.logger).Log("msg", "减少库存失败，错误[1]："+err.Error())
-		return nil, status.Error(codes.Aborted, "减少库存失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.Internal, "减少库存失败，错误[1]："+err.Error())
 	}
 	return &productPBV1.Response{}, nil
 
@@ -153,10 +161,108 @@ func (s *Server) DecreaseStock(ctx context.Context, request *productPBV1.Decreas
 // 这个接口用于执行 saga 事务失败的时候调用
 func (s *Server) DecreaseStockRevert(ctx context.Context, request *productPBV1.DecreaseStockRequest) (*productPBV1.Response, error) {
 
+	// 补偿操作不能返回 Aborted，失败时由 dtm 重试直到成功
 	if err := s.repo.IncreaseStock(ctx, request.Id, request.Quantity); err != nil {
 		_ = level.Error(s.logger).Log("msg", "回滚库存失败，错误[1]："+err.Error())
-		return nil, status.Error(codes.Aborted, "回滚库存失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.Internal, "回滚库存失败，错误[1]："+err.Error())
+	}
+	return &productPBV1.Response{}, nil
+
+}
+
+// ReserveStock 预留库存操作
+// 这个接口用于执行 saga 事务成功的时候调用。预留的库存在订单支付后确认，超时未确认时自动释放
+func (s *Server) ReserveStock(ctx context.Context, request *productPBV1.ReserveStockRequest) (*productPBV1.Response, error) {
+
+	if err := s.repo.ReserveStock(ctx, request.OrderNo, request.Id, request.Quantity); err != nil {
+		switch {
+		case errors.Is(err, ErrOutOfStock):
+			return nil, businessError(err, productPBV1.ErrorReason_ERROR_REASON_OUT_OF_STOCK)
+		case errors.Is(err, ErrProductNotFound):
+			return nil, businessError(err, productPBV1.ErrorReason_ERROR_REASON_PRODUCT_NOT_FOUND)
+		}
+		// 其他错误由 dtm 重试
+		_ = level.Error(s.logger).Log("msg", "预留库存失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.Internal, "预留库存失败，错误[1]："+err.Error())
+	}
+	return &productPBV1.Response{}, nil
+
+}
+
+// ReserveStockRevert 释放预留库存操作
+// 这个接口用于执行 saga 事务失败的时候调用
+func (s *Server) ReserveStockRevert(ctx context.Context, request *productPBV1.ReserveStockRequest) (*productPBV1.Response, error) {
+
+	// 补偿操作不能返回 Aborted，失败时由 dtm 重试直到成功
+	if err := s.repo.ReleaseReservedStock(ctx, request.OrderNo, request.Id); err != nil {
+		_ = level.Error(s.logger).Log("msg", "释放预留库存失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.Internal, "释放预留库存失败，错误[1]："+err.Error())
+	}
+	return &productPBV1.Response{}, nil
+
+}
+
+// ConfirmReservation 订单支付后确认预留库存
+func (s *Server) ConfirmReservation(ctx context.Context, request *productPBV1.ReservationRequest) (*productPBV1.Response, error) {
+
+	if err := s.repo.ConfirmReservation(ctx, request.OrderNo); err != nil {
+		if errors.Is(err, ErrReservationExpired) {
+			return nil, businessError(err, productPBV1.ErrorReason_ERROR_REASON_RESERVATION_EXPIRED)
+		}
+		_ = level.Error(s.logger).Log("msg", "确认预留库存失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.Internal, "确认预留库存失败")
 	}
 	return &productPBV1.Response{}, nil
 
 }
+
+// CancelReservation 订单取消后释放预留库存
+func (s *Server) CancelReservation(ctx context.Context, request *productPBV1.ReservationRequest) (*productPBV1.Response, error) {
+
+	if err := s.repo.CancelReservation(ctx, request.OrderNo); err != nil {
+		_ = level.Error(s.logger).Log("msg", "取消预留库存失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.Internal, "取消预留库存失败")
+	}
+	return &productPBV1.Response{}, nil
+
+}
+
+// StockInfo 获取产品可售以及预留库存
+func (s *Server) StockInfo(ctx context.Context, request *productPBV1.DetailRequest) (*productPBV1.Response, error) {
+
+	product, err := s.repo.StockInfo(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "产品不存在")
+		}
+		_ = level.Error(s.logger).Log("msg", "获取产品库存失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.Internal, "获取产品库存失败")
+	}
+
+	anyData, err := anypb.New(&productPBV1.StockDetail{
+		Id:        product.ID,
+		Available: product.Stock,
+		Reserved:  product.Reserved,
+	})
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "获取产品库存失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "获取产品库存失败")
+	}
+	return &productPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// businessError 业务失败错误。dtm 收到 Aborted 时不再重试并执行补偿，
+// http 网关返回 409 以及错误信息，ErrorInfo 中的 reason 用于调用方区分错误原因
+func businessError(err error, reason productPBV1.ErrorReason) error {
+
+	st, detailErr := status.New(codes.Aborted, err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: reason.String(),
+		Domain: productPBV1.ProductService_ServiceDesc.ServiceName,
+	})
+	if detailErr != nil {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return st.Err()
+
+}
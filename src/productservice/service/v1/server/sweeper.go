@@ -0,0 +1,68 @@
+package serverV1
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+
+	"productservice/config"
+)
+
+// ReservationSweeper 定时释放过期的库存预留。超时未支付的订单不会一直占用库存
+type ReservationSweeper struct {
+	conf   *config.Config
+	logger log.Logger
+	repo   *Repository
+}
+
+// NewReservationSweeper 实例化 ReservationSweeper
+func NewReservationSweeper(
+	conf *config.Config,
+	logger log.Logger,
+	repo *Repository,
+) *ReservationSweeper {
+	return &ReservationSweeper{
+		conf:   conf,
+		logger: logger,
+		repo:   repo,
+	}
+}
+
+// Run 每隔 conf.Reservation.SweepInterval 释放一次过期预留，直到 ctx 取消
+func (s *ReservationSweeper) Run(ctx context.Context) error {
+
+	ticker := time.NewTicker(s.conf.Reservation.SweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			s.sweep(ctx)
+		}
+	}
+
+}
+
+// sweep 分批释放过期预留。一批释放满时继续下一批，直到没有过期预留
+func (s *ReservationSweeper) sweep(ctx context.Context) {
+
+	for ctx.Err() == nil {
+		released, err := s.repo.ReleaseExpiredReservations(ctx, s.conf.Reservation.SweepBatch)
+		if err != nil {
+			_ = level.Error(s.logger).Log("msg", "释放过期预留库存失败，错误[1]："+err.Error())
+			return
+		}
+		if released > 0 {
+			_ = level.Info(s.logger).Log("msg", "释放过期预留库存："+strconv.Itoa(released))
+		}
+		if released < s.conf.Reservation.SweepBatch {
+			return
+		}
+	}
+
+}
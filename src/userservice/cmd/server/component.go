@@ -2,6 +2,8 @@ package server
 
 import (
 	"context"
+	"crypto"
+	"errors"
 	"os"
 	"strconv"
 
@@ -19,7 +21,10 @@ import (
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 
+	"authservice/pkg/token"
 	"userservice/config"
+	"userservice/pkg/notify"
+	"userservice/pkg/password"
 )
 
 // NewRedis 实例化 redis 组件
@@ -142,3 +147,80 @@ func NewTrace(conf *config.Config) (*sdktrace.TracerProvider, error) {
 	return tracerProvider, nil
 
 }
+
+// NewTokenSigner 实例化 access token 签名器
+func NewTokenSigner(conf *config.Config) (*token.Signer, error) {
+
+	var key crypto.Signer
+	var err error
+	if conf.Token.PrivateKeyFile == "" {
+		if !conf.Token.DevKey {
+			return nil, errors.New("token private key file is not configured")
+		}
+		// 开发模式下未配置私钥时随机生成。重启后已签发的 token 全部失效
+		key, err = token.GenerateKey(conf.Token.Algorithm)
+	} else {
+		var data []byte
+		data, err = os.ReadFile(conf.Token.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		key, err = token.ParsePrivateKey(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 密钥轮换期间继续发布旧公钥
+	extraKeys := make(map[string]crypto.PublicKey)
+	for _, v := range conf.Token.PreviousKeys {
+		data, err := os.ReadFile(v.PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		pub, err := token.ParsePublicKey(data)
+		if err != nil {
+			return nil, err
+		}
+		extraKeys[v.KeyID] = pub
+	}
+
+	return token.NewSigner(conf.Token.Algorithm, conf.Token.KeyID, key, extraKeys)
+
+}
+
+// NewNotifier 实例化通知发送组件
+func NewNotifier(conf *config.Config, logger log.Logger) (notify.Notifier, error) {
+
+	switch conf.Notifier.Driver {
+	case "", "log":
+		return notify.NewLogNotifier(logger), nil
+	case "file":
+		notifier, err := notify.NewFileNotifier(conf.Notifier.File)
+		if err != nil {
+			return nil, err
+		}
+		return notifier, nil
+	default:
+		return nil, errors.New("unsupported notifier driver " + conf.Notifier.Driver)
+	}
+
+}
+
+// NewPasswordHasher 实例化密码哈希组件
+func NewPasswordHasher(conf *config.Config) (*password.Hasher, error) {
+	a := conf.Password.Argon2
+	return password.NewHasher(conf.Password.Algorithm, conf.Password.BcryptCost, password.Argon2Params{
+		Memory:      a.Memory,
+		Iterations:  a.Iterations,
+		Parallelism: a.Parallelism,
+		SaltLength:  a.SaltLength,
+		KeyLength:   a.KeyLength,
+	})
+}
+
+// NewPasswordPolicy 实例化密码策略组件
+func NewPasswordPolicy(conf *config.Config) (*password.Policy, error) {
+	c := conf.Password
+	return password.NewPolicy(c.MinLength, c.MaxLength, c.MinClasses, c.BlocklistFile)
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"net"
 	"net/http"
 	"os"
@@ -19,6 +20,8 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"gorm.io/gorm"
 
+	"authservice/pkg/identity"
+	"authservice/pkg/token"
 	"userservice/config"
 	userPBV1 "userservice/genproto/go/v1"
 	"userservice/service/model"
@@ -67,10 +70,14 @@ func NewGrpcServer(serviceServerV1 userPBV1.UserServiceServer) *grpc.Server {
 		grpc.ChainStreamInterceptor(
 			// otel 链路追踪
 			otelgrpc.StreamServerInterceptor(),
+			// 加载 authservice 注入的调用方身份
+			identity.StreamServerInterceptor(),
 		),
 		grpc.ChainUnaryInterceptor(
 			// otel 链路追踪
 			otelgrpc.UnaryServerInterceptor(),
+			// 加载 authservice 注入的调用方身份
+			identity.UnaryServerInterceptor(),
 			// PGV 中间件
 			Jgrpc_pgv_interceptor.ValidationUnaryInterceptor,
 		),
@@ -81,12 +88,13 @@ func NewGrpcServer(serviceServerV1 userPBV1.UserServiceServer) *grpc.Server {
 }
 
 // NewHttpServer 实例化 Http 服务
-func NewHttpServer(conf *config.Config) *http.Server {
+func NewHttpServer(conf *config.Config, signer *token.Signer) *http.Server {
 
 	mux := runtime.NewServeMux(
 		runtime.WithErrorHandler(Jgrpc_response.HttpErrorHandler),
 		runtime.WithForwardResponseOption(Jgrpc_response.HttpSuccessResponseModifier),
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &Jgrpc_response.CustomMarshaller{}),
+		runtime.WithIncomingHeaderMatcher(identity.HeaderMatcher(runtime.DefaultHeaderMatcher)),
 	)
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -100,6 +108,14 @@ func NewHttpServer(conf *config.Config) *http.Server {
 		panic("register service handler failed.[ERROR]=>" + err.Error())
 	}
 
+	// 发布 access token 公钥。供 istio RequestAuthentication 以及 authservice 本地验证 token
+	if err := mux.HandlePath(http.MethodGet, "/user.v1.jwks", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(signer.JWKS())
+	}); err != nil {
+		panic("register jwks handler failed.[ERROR]=>" + err.Error())
+	}
+
 	httpServer := &http.Server{
 		Addr:    conf.Http.Port,
 		Handler: mux,
@@ -159,6 +175,11 @@ func Run(cfg string) {
 	// 执行 migrate
 	model.Migrate(server.mysqlDB)
 
+	// 初始化并同步角色权限
+	if err = server.repo.SyncRbac(context.Background()); err != nil {
+		panic("sync rbac failed.[ERROR]=>" + err.Error())
+	}
+
 	// 上报链路 trace 数据
 	defer func() {
 		if err = server.trace.Shutdown(context.Background()); err != nil {
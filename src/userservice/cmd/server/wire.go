@@ -7,6 +7,8 @@ import (
 	"github.com/google/wire"
 	Jgrpc_otelspan "github.com/janrs-io/Jgrpc-otel-span"
 
+	"authservice/pkg/rbac"
+	"authservice/pkg/session"
 	"userservice/config"
 	clientV1 "userservice/service/v1/client"
 	serverV1 "userservice/service/v1/server"
@@ -23,6 +25,7 @@ func InitServer(cfg string) (*Server, error) {
 		// 实例化服务
 		serverV1.NewServer,
 		serverV1.NewRepository,
+		serverV1.NewLoginGuard,
 
 		// 客户端
 		clientV1.NewUserClient,
@@ -31,8 +34,14 @@ func InitServer(cfg string) (*Server, error) {
 
 		// 组件
 		NewRedis,
+		session.NewStore,
+		rbac.NewStore,
 		NewMysqlDB,
 		NewHttpServer,
+		NewTokenSigner,
+		NewNotifier,
+		NewPasswordHasher,
+		NewPasswordPolicy,
 		NewGrpcServer,
 		NewRunGroup,
 		NewLogger,
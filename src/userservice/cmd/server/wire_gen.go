@@ -7,6 +7,8 @@
 package server
 
 import (
+	"authservice/pkg/rbac"
+	"authservice/pkg/session"
 	"github.com/janrs-io/Jgrpc-otel-span"
 	"userservice/config"
 	"userservice/service/v1/client"
@@ -19,6 +21,26 @@ func InitServer(cfg string) (*Server, error) {
 	configConfig := config.NewConfig(cfg)
 	db := NewMysqlDB(configConfig)
 	client := NewRedis(configConfig)
+	store := session.NewStore(client)
+	rbacStore := rbac.NewStore(client)
+	loginGuard := serverV1.NewLoginGuard(client, configConfig)
+	logger := NewLogger()
+	notifier, err := NewNotifier(configConfig, logger)
+	if err != nil {
+		return nil, err
+	}
+	hasher, err := NewPasswordHasher(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := NewPasswordPolicy(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := NewTokenSigner(configConfig)
+	if err != nil {
+		return nil, err
+	}
 	orderServiceClient, err := clientV1.NewOrderClient(configConfig)
 	if err != nil {
 		return nil, err
@@ -36,10 +58,9 @@ func InitServer(cfg string) (*Server, error) {
 		return nil, err
 	}
 	otelSpan := Jgrpc_otelspan.New(tracerProvider)
-	repository := serverV1.NewRepository(db, client, orderServiceClient, productServiceClient, userServiceClient, otelSpan, configConfig)
+	repository := serverV1.NewRepository(db, client, store, rbacStore, loginGuard, notifier, hasher, policy, signer, orderServiceClient, productServiceClient, userServiceClient, otelSpan, configConfig)
 	group := NewRunGroup()
-	logger := NewLogger()
-	server := NewHttpServer(configConfig)
+	server := NewHttpServer(configConfig, signer)
 	userServiceServer := serverV1.NewServer(repository, logger, userServiceClient, orderServiceClient, productServiceClient)
 	grpcServer := NewGrpcServer(userServiceServer)
 	serverServer := NewServer(repository, configConfig, group, logger, server, grpcServer, db, tracerProvider)
@@ -6,12 +6,23 @@ import (
 
 // Config Service config
 type Config struct {
-	Grpc     Grpc     `json:"grpc" yaml:"grpc"`
-	Http     Http     `json:"http" yaml:"http"`
-	Database Database `json:"database" yaml:"database"`
-	Redis    Redis    `json:"redis" yaml:"redis"`
-	Client   Client   `json:"client" yaml:"client"`
-	Trace    Trace    `json:"trace" yaml:"trace"`
+	Grpc          Grpc          `json:"grpc" yaml:"grpc"`
+	Http          Http          `json:"http" yaml:"http"`
+	Database      Database      `json:"database" yaml:"database"`
+	Redis         Redis         `json:"redis" yaml:"redis"`
+	Client        Client        `json:"client" yaml:"client"`
+	Trace         Trace         `json:"trace" yaml:"trace"`
+	Token         Token         `json:"token" yaml:"token"`
+	Rbac          Rbac          `json:"rbac" yaml:"rbac"`
+	Session       Session       `json:"session" yaml:"session"`
+	LoginGuard    LoginGuard    `json:"loginGuard" yaml:"loginGuard"`
+	Notifier      Notifier      `json:"notifier" yaml:"notifier"`
+	Verification  Verification  `json:"verification" yaml:"verification"`
+	PasswordReset PasswordReset `json:"passwordReset" yaml:"passwordReset"`
+	Mfa           Mfa           `json:"mfa" yaml:"mfa"`
+	Password      Password      `json:"password" yaml:"password"`
+	Visibility    Visibility    `json:"visibility" yaml:"visibility"`
+	Proxy         Proxy         `json:"proxy" yaml:"proxy"`
 }
 
 // NewConfig Initial service's config
@@ -22,6 +33,7 @@ func NewConfig(cfg string) *Config {
 	}
 
 	viper.SetConfigFile(cfg)
+	setMfaDefaults()
 
 	// Read config file
 	if err := viper.ReadInConfig(); err != nil {
@@ -32,6 +44,9 @@ func NewConfig(cfg string) *Config {
 	if err := viper.Unmarshal(conf); err != nil {
 		panic("assign config failed.[ERROR]=>" + err.Error())
 	}
+	if err := conf.Mfa.validate(); err != nil {
+		panic("invalid config.[ERROR]=>" + err.Error())
+	}
 
 	return conf
 
@@ -0,0 +1,22 @@
+package config
+
+import "time"
+
+// LoginGuard 登录防暴力破解配置。失败次数分别按用户名以及客户端 IP 统计
+type LoginGuard struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// 失败计数有效期。最后一次失败后超过该时间计数清零
+	Window time.Duration `json:"window" yaml:"window"`
+	// 不限制的失败次数。超过后每次失败都需要等待一段时间才能再次尝试
+	FreeAttempts int64 `json:"freeAttempts" yaml:"freeAttempts"`
+	// 第一次退避的等待时间，之后每次失败翻倍
+	BackoffBase time.Duration `json:"backoffBase" yaml:"backoffBase"`
+	// 退避等待时间上限
+	BackoffMax time.Duration `json:"backoffMax" yaml:"backoffMax"`
+	// 同一用户名失败达到该次数后锁定。为 0 时不锁定
+	UserLockoutThreshold int64 `json:"userLockoutThreshold" yaml:"userLockoutThreshold"`
+	// 同一客户端 IP 失败达到该次数后锁定。为 0 时不锁定
+	IPLockoutThreshold int64 `json:"ipLockoutThreshold" yaml:"ipLockoutThreshold"`
+	// 锁定时长
+	LockoutDuration time.Duration `json:"lockoutDuration" yaml:"lockoutDuration"`
+}
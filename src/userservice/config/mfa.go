@@ -0,0 +1,45 @@
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// Mfa 二次验证配置
+type Mfa struct {
+	// 身份验证器 App 中显示的发行方
+	Issuer string `json:"issuer" yaml:"issuer"`
+	// 登录第二步 challenge token 有效期
+	ChallengeTTL time.Duration `json:"challengeTTL" yaml:"challengeTTL"`
+	// 每个 challenge token 最多可以尝试的次数
+	MaxAttempts int64 `json:"maxAttempts" yaml:"maxAttempts"`
+	// 开启时生成的恢复码数量
+	RecoveryCodes int `json:"recoveryCodes" yaml:"recoveryCodes"`
+}
+
+// setMfaDefaults 二次验证配置默认值
+func setMfaDefaults() {
+	viper.SetDefault("mfa.issuer", "Jgrpc")
+	viper.SetDefault("mfa.challengeTTL", 5*time.Minute)
+	viper.SetDefault("mfa.maxAttempts", 5)
+	viper.SetDefault("mfa.recoveryCodes", 10)
+}
+
+// validate 检查二次验证配置
+func (m Mfa) validate() error {
+
+	switch {
+	case m.Issuer == "":
+		return errors.New("mfa.issuer can not be empty")
+	case m.ChallengeTTL <= 0:
+		return errors.New("mfa.challengeTTL must be greater than 0")
+	case m.MaxAttempts <= 0:
+		return errors.New("mfa.maxAttempts must be greater than 0")
+	case m.RecoveryCodes <= 0:
+		return errors.New("mfa.recoveryCodes must be greater than 0")
+	}
+	return nil
+
+}
@@ -0,0 +1,8 @@
+package config
+
+// Notifier 通知发送配置
+type Notifier struct {
+	// 发送方式[log/file]。log 写到服务日志，file 追加写入 File 指定的文件
+	Driver string `json:"driver" yaml:"driver"`
+	File   string `json:"file" yaml:"file"`
+}
@@ -0,0 +1,27 @@
+package config
+
+// Password 密码策略以及哈希配置
+type Password struct {
+	// 最小长度
+	MinLength int `json:"minLength" yaml:"minLength"`
+	// 最大长度。bcrypt 只使用前 72 个字节
+	MaxLength int `json:"maxLength" yaml:"maxLength"`
+	// 至少需要包含的字符种类数量[大写字母/小写字母/数字/符号]
+	MinClasses int `json:"minClasses" yaml:"minClasses"`
+	// 常见密码或者已泄露密码列表文件，每行一个。为空时不检查
+	BlocklistFile string `json:"blocklistFile" yaml:"blocklistFile"`
+	// 哈希算法[bcrypt/argon2id]。修改后旧哈希在用户登录时自动更新
+	Algorithm  string `json:"algorithm" yaml:"algorithm"`
+	BcryptCost int    `json:"bcryptCost" yaml:"bcryptCost"`
+	Argon2     Argon2 `json:"argon2" yaml:"argon2"`
+}
+
+// Argon2 argon2id 参数
+type Argon2 struct {
+	// 内存大小，单位 KiB
+	Memory      uint32 `json:"memory" yaml:"memory"`
+	Iterations  uint32 `json:"iterations" yaml:"iterations"`
+	Parallelism uint8  `json:"parallelism" yaml:"parallelism"`
+	SaltLength  uint32 `json:"saltLength" yaml:"saltLength"`
+	KeyLength   uint32 `json:"keyLength" yaml:"keyLength"`
+}
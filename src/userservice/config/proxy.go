@@ -0,0 +1,8 @@
+package config
+
+// Proxy 反向代理配置。用于从 x-forwarded-for 中获取真实的客户端 IP
+type Proxy struct {
+	// grpc-gateway 前面可信代理的层数。每层代理都会在 x-forwarded-for 末尾追加上一跳的地址，
+	// 客户端 IP 为从右往左跳过可信代理后的地址。更左边的地址由客户端提供，不可信
+	TrustedProxies int `json:"trustedProxies" yaml:"trustedProxies"`
+}
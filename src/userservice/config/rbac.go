@@ -0,0 +1,7 @@
+package config
+
+// Rbac 角色权限配置
+type Rbac struct {
+	// 启动时授予 admin 角色的用户名。用于初始化第一个管理员
+	BootstrapAdmins []string `json:"bootstrapAdmins" yaml:"bootstrapAdmins"`
+}
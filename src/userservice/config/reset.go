@@ -0,0 +1,13 @@
+package config
+
+import "time"
+
+// PasswordReset 找回密码配置
+type PasswordReset struct {
+	// 重置 token 有效期
+	TokenTTL time.Duration `json:"tokenTTL" yaml:"tokenTTL"`
+	// 同一用户两次发送重置链接的最小间隔
+	ResendInterval time.Duration `json:"resendInterval" yaml:"resendInterval"`
+	// 重置页面地址。发送时在后面拼接 token
+	URL string `json:"url" yaml:"url"`
+}
@@ -0,0 +1,11 @@
+package config
+
+import "time"
+
+// Session 登录会话配置
+type Session struct {
+	// 空闲超时。超过该时间没有任何请求时会话过期，每次请求或者刷新 token 都会顺延
+	IdleTimeout time.Duration `json:"idleTimeout" yaml:"idleTimeout"`
+	// 绝对有效期。从登录开始计算，到期后无论是否活跃都需要重新登录
+	MaxLifetime time.Duration `json:"maxLifetime" yaml:"maxLifetime"`
+}
@@ -0,0 +1,29 @@
+package config
+
+import "time"
+
+// Token access token 以及 refresh token 配置
+type Token struct {
+	// 签名算法[RS256/EdDSA]
+	Algorithm string `json:"algorithm" yaml:"algorithm"`
+	// 当前签名密钥 ID
+	KeyID string `json:"keyId" yaml:"keyId"`
+	// PEM 格式私钥文件。为空时启动失败，开启 DevKey 时随机生成
+	PrivateKeyFile string `json:"privateKeyFile" yaml:"privateKeyFile"`
+	// 开发模式。未配置私钥文件时启动随机生成私钥，重启后已签发的 token 全部失效，只用于本地开发
+	DevKey bool `json:"devKey" yaml:"devKey"`
+	// 额外发布到 JWKS 的公钥，用于密钥轮换期间验证旧 token
+	PreviousKeys []PreviousKey `json:"previousKeys" yaml:"previousKeys"`
+	Issuer       string        `json:"issuer" yaml:"issuer"`
+	Audience     []string      `json:"audience" yaml:"audience"`
+	// access token 有效期
+	AccessTokenTTL time.Duration `json:"accessTokenTTL" yaml:"accessTokenTTL"`
+	// refresh token 有效期。不会超过登录会话的绝对有效期
+	RefreshTokenTTL time.Duration `json:"refreshTokenTTL" yaml:"refreshTokenTTL"`
+}
+
+// PreviousKey 已经停止签名但是仍需验证的公钥
+type PreviousKey struct {
+	KeyID         string `json:"keyId" yaml:"keyId"`
+	PublicKeyFile string `json:"publicKeyFile" yaml:"publicKeyFile"`
+}
@@ -0,0 +1,15 @@
+package config
+
+import "time"
+
+// Verification 邮箱以及手机号验证码配置
+type Verification struct {
+	// 验证码有效期
+	CodeTTL time.Duration `json:"codeTTL" yaml:"codeTTL"`
+	// 同一渠道两次发送的最小间隔
+	ResendInterval time.Duration `json:"resendInterval" yaml:"resendInterval"`
+	// 同一渠道每天最多发送次数。为 0 时不限制
+	DailyLimit int64 `json:"dailyLimit" yaml:"dailyLimit"`
+	// 验证码最多可以尝试的次数。超过后验证码失效
+	MaxAttempts int64 `json:"maxAttempts" yaml:"maxAttempts"`
+}
@@ -0,0 +1,9 @@
+package config
+
+// Visibility 用户敏感字段可见性配置
+type Visibility struct {
+	// 本人查看完整敏感字段需要的权限。没有该权限时只能看到脱敏后的值
+	ElevatedPermission string `json:"elevatedPermission" yaml:"elevatedPermission"`
+	// 查看任意用户完整敏感字段需要的权限
+	AdminPermission string `json:"adminPermission" yaml:"adminPermission"`
+}
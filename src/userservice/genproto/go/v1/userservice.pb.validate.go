@@ -79,21 +79,10 @@ func (m *RegisterRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetPassword()); l < 6 || l > 16 {
+	if l := utf8.RuneCountInString(m.GetPassword()); l < 1 || l > 128 {
 		err := RegisterRequestValidationError{
 			field:  "Password",
-			reason: "value length must be between 6 and 16 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_RegisterRequest_Password_Pattern.MatchString(m.GetPassword()) {
-		err := RegisterRequestValidationError{
-			field:  "Password",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]*$\"",
+			reason: "value length must be between 1 and 128 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -181,8 +170,6 @@ var _ interface {
 
 var _RegisterRequest_Username_Pattern = regexp.MustCompile("^[a-zA-Z0-9_]*$")
 
-var _RegisterRequest_Password_Pattern = regexp.MustCompile("^[a-zA-Z0-9]*$")
-
 // Validate checks the field values on RegisterResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -434,6 +421,18 @@ func (m *LoginResponse) validate(all bool) error {
 
 	// no validation rules for ExpireIn
 
+	// no validation rules for RefreshToken
+
+	// no validation rules for RefreshExpireIn
+
+	// no validation rules for TokenType
+
+	// no validation rules for MfaRequired
+
+	// no validation rules for MfaToken
+
+	// no validation rules for MfaExpireIn
+
 	if len(errors) > 0 {
 		return LoginResponseMultiError(errors)
 	}
@@ -512,6 +511,117 @@ var _ interface {
 	ErrorName() string
 } = LoginResponseValidationError{}
 
+// Validate checks the field values on RefreshRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RefreshRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RefreshRequestMultiError,
+// or nil if none found.
+func (m *RefreshRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRefreshToken()) < 1 {
+		err := RefreshRequestValidationError{
+			field:  "RefreshToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RefreshRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshRequestMultiError is an error wrapping multiple validation errors
+// returned by RefreshRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RefreshRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshRequestMultiError) AllErrors() []error { return m }
+
+// RefreshRequestValidationError is the validation error returned by
+// RefreshRequest.Validate if the designated constraints aren't met.
+type RefreshRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshRequestValidationError) ErrorName() string { return "RefreshRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RefreshRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshRequestValidationError{}
+
 // Validate checks the field values on InfoResponse with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -662,10 +772,36 @@ func (m *UpdateRequest) validate(all bool) error {
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+	if m.GetUsername() != "" {
+
+		if l := utf8.RuneCountInString(m.GetUsername()); l < 6 || l > 16 {
+			err := UpdateRequestValidationError{
+				field:  "Username",
+				reason: "value length must be between 6 and 16 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_UpdateRequest_Username_Pattern.MatchString(m.GetUsername()) {
+			err := UpdateRequestValidationError{
+				field:  "Username",
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9_]*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _UpdateRequest_Sex_InLookup[m.GetSex()]; !ok {
 		err := UpdateRequestValidationError{
-			field:  "Username",
-			reason: "value length must be at least 1 runes",
+			field:  "Sex",
+			reason: "value must be in list [0 1 2]",
 		}
 		if !all {
 			return err
@@ -673,10 +809,10 @@ func (m *UpdateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetPassword()) < 1 {
+	if utf8.RuneCountInString(m.GetNickName()) > 20 {
 		err := UpdateRequestValidationError{
-			field:  "Password",
-			reason: "value length must be at least 1 runes",
+			field:  "NickName",
+			reason: "value length must be at most 20 runes",
 		}
 		if !all {
 			return err
@@ -684,7 +820,98 @@ func (m *UpdateRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Sex
+	if utf8.RuneCountInString(m.GetRealName()) > 10 {
+		err := UpdateRequestValidationError{
+			field:  "RealName",
+			reason: "value length must be at most 10 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEmail() != "" {
+
+		if utf8.RuneCountInString(m.GetEmail()) > 255 {
+			err := UpdateRequestValidationError{
+				field:  "Email",
+				reason: "value length must be at most 255 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if err := m._validateEmail(m.GetEmail()); err != nil {
+			err = UpdateRequestValidationError{
+				field:  "Email",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetPhone() != "" {
+
+		if !_UpdateRequest_Phone_Pattern.MatchString(m.GetPhone()) {
+			err := UpdateRequestValidationError{
+				field:  "Phone",
+				reason: "value does not match regex pattern \"^\\\\+?[0-9]{6,19}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetUpdateMask() == nil {
+		err := UpdateRequestValidationError{
+			field:  "UpdateMask",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
 		return UpdateRequestMultiError(errors)
@@ -693,6 +920,56 @@ func (m *UpdateRequest) validate(all bool) error {
 	return nil
 }
 
+func (m *UpdateRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *UpdateRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
 // UpdateRequestMultiError is an error wrapping multiple validation errors
 // returned by UpdateRequest.ValidateAll() if the designated constraints
 // aren't met.
@@ -764,6 +1041,16 @@ var _ interface {
 	ErrorName() string
 } = UpdateRequestValidationError{}
 
+var _UpdateRequest_Username_Pattern = regexp.MustCompile("^[a-zA-Z0-9_]*$")
+
+var _UpdateRequest_Sex_InLookup = map[int64]struct{}{
+	0: {},
+	1: {},
+	2: {},
+}
+
+var _UpdateRequest_Phone_Pattern = regexp.MustCompile("^\\+?[0-9]{6,19}$")
+
 // Validate checks the field values on UpdateResponse with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -866,32 +1153,32 @@ var _ interface {
 	ErrorName() string
 } = UpdateResponseValidationError{}
 
-// Validate checks the field values on OrderInfoRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *OrderInfoRequest) Validate() error {
+// Validate checks the field values on ChangePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangePasswordRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrderInfoRequest with the rules
+// ValidateAll checks the field values on ChangePasswordRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// OrderInfoRequestMultiError, or nil if none found.
-func (m *OrderInfoRequest) ValidateAll() error {
+// ChangePasswordRequestMultiError, or nil if none found.
+func (m *ChangePasswordRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrderInfoRequest) validate(all bool) error {
+func (m *ChangePasswordRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetOrderId() < 1 {
-		err := OrderInfoRequestValidationError{
-			field:  "OrderId",
-			reason: "value must be greater than or equal to 1",
+	if utf8.RuneCountInString(m.GetOldPassword()) < 1 {
+		err := ChangePasswordRequestValidationError{
+			field:  "OldPassword",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -899,10 +1186,10 @@ func (m *OrderInfoRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetProductId() < 1 {
-		err := OrderInfoRequestValidationError{
-			field:  "ProductId",
-			reason: "value must be greater than or equal to 1",
+	if l := utf8.RuneCountInString(m.GetNewPassword()); l < 1 || l > 128 {
+		err := ChangePasswordRequestValidationError{
+			field:  "NewPassword",
+			reason: "value length must be between 1 and 128 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -911,19 +1198,19 @@ func (m *OrderInfoRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return OrderInfoRequestMultiError(errors)
+		return ChangePasswordRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrderInfoRequestMultiError is an error wrapping multiple validation errors
-// returned by OrderInfoRequest.ValidateAll() if the designated constraints
-// aren't met.
-type OrderInfoRequestMultiError []error
+// ChangePasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by ChangePasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ChangePasswordRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrderInfoRequestMultiError) Error() string {
+func (m ChangePasswordRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -932,11 +1219,11 @@ func (m OrderInfoRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrderInfoRequestMultiError) AllErrors() []error { return m }
+func (m ChangePasswordRequestMultiError) AllErrors() []error { return m }
 
-// OrderInfoRequestValidationError is the validation error returned by
-// OrderInfoRequest.Validate if the designated constraints aren't met.
-type OrderInfoRequestValidationError struct {
+// ChangePasswordRequestValidationError is the validation error returned by
+// ChangePasswordRequest.Validate if the designated constraints aren't met.
+type ChangePasswordRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -944,22 +1231,24 @@ type OrderInfoRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrderInfoRequestValidationError) Field() string { return e.field }
+func (e ChangePasswordRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrderInfoRequestValidationError) Reason() string { return e.reason }
+func (e ChangePasswordRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrderInfoRequestValidationError) Cause() error { return e.cause }
+func (e ChangePasswordRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrderInfoRequestValidationError) Key() bool { return e.key }
+func (e ChangePasswordRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrderInfoRequestValidationError) ErrorName() string { return "OrderInfoRequestValidationError" }
+func (e ChangePasswordRequestValidationError) ErrorName() string {
+	return "ChangePasswordRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e OrderInfoRequestValidationError) Error() string {
+func (e ChangePasswordRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -971,14 +1260,14 @@ func (e OrderInfoRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrderInfoRequest.%s: %s%s",
+		"invalid %sChangePasswordRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrderInfoRequestValidationError{}
+var _ error = ChangePasswordRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -986,131 +1275,3184 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrderInfoRequestValidationError{}
+} = ChangePasswordRequestValidationError{}
 
-// Validate checks the field values on OrderInfoResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *OrderInfoResponse) Validate() error {
+// Validate checks the field values on SendVerificationCodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendVerificationCodeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrderInfoResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SendVerificationCodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// OrderInfoResponseMultiError, or nil if none found.
-func (m *OrderInfoResponse) ValidateAll() error {
+// SendVerificationCodeRequestMultiError, or nil if none found.
+func (m *SendVerificationCodeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrderInfoResponse) validate(all bool) error {
+func (m *SendVerificationCodeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUserInfo()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrderInfoResponseValidationError{
-					field:  "UserInfo",
-					reason: "embedded message failed validation",
-					cause:  err,
+	if _, ok := _SendVerificationCodeRequest_Channel_InLookup[m.GetChannel()]; !ok {
+		err := SendVerificationCodeRequestValidationError{
+			field:  "Channel",
+			reason: "value must be in list [email phone]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SendVerificationCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendVerificationCodeRequestMultiError is an error wrapping multiple
+// validation errors returned by SendVerificationCodeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SendVerificationCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendVerificationCodeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendVerificationCodeRequestMultiError) AllErrors() []error { return m }
+
+// SendVerificationCodeRequestValidationError is the validation error returned
+// by SendVerificationCodeRequest.Validate if the designated constraints
+// aren't met.
+type SendVerificationCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendVerificationCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendVerificationCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendVerificationCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendVerificationCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendVerificationCodeRequestValidationError) ErrorName() string {
+	return "SendVerificationCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendVerificationCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendVerificationCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendVerificationCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendVerificationCodeRequestValidationError{}
+
+var _SendVerificationCodeRequest_Channel_InLookup = map[string]struct{}{
+	"email": {},
+	"phone": {},
+}
+
+// Validate checks the field values on SendVerificationCodeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendVerificationCodeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendVerificationCodeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendVerificationCodeResponseMultiError, or nil if none found.
+func (m *SendVerificationCodeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendVerificationCodeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ExpireAt
+
+	if len(errors) > 0 {
+		return SendVerificationCodeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendVerificationCodeResponseMultiError is an error wrapping multiple
+// validation errors returned by SendVerificationCodeResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SendVerificationCodeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendVerificationCodeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendVerificationCodeResponseMultiError) AllErrors() []error { return m }
+
+// SendVerificationCodeResponseValidationError is the validation error returned
+// by SendVerificationCodeResponse.Validate if the designated constraints
+// aren't met.
+type SendVerificationCodeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendVerificationCodeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendVerificationCodeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendVerificationCodeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendVerificationCodeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendVerificationCodeResponseValidationError) ErrorName() string {
+	return "SendVerificationCodeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendVerificationCodeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendVerificationCodeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendVerificationCodeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendVerificationCodeResponseValidationError{}
+
+// Validate checks the field values on VerifyContactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyContactRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyContactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyContactRequestMultiError, or nil if none found.
+func (m *VerifyContactRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyContactRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _VerifyContactRequest_Channel_InLookup[m.GetChannel()]; !ok {
+		err := VerifyContactRequestValidationError{
+			field:  "Channel",
+			reason: "value must be in list [email phone]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_VerifyContactRequest_Code_Pattern.MatchString(m.GetCode()) {
+		err := VerifyContactRequestValidationError{
+			field:  "Code",
+			reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VerifyContactRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyContactRequestMultiError is an error wrapping multiple validation
+// errors returned by VerifyContactRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyContactRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyContactRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyContactRequestMultiError) AllErrors() []error { return m }
+
+// VerifyContactRequestValidationError is the validation error returned by
+// VerifyContactRequest.Validate if the designated constraints aren't met.
+type VerifyContactRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyContactRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyContactRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyContactRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyContactRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyContactRequestValidationError) ErrorName() string {
+	return "VerifyContactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyContactRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyContactRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyContactRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyContactRequestValidationError{}
+
+var _VerifyContactRequest_Channel_InLookup = map[string]struct{}{
+	"email": {},
+	"phone": {},
+}
+
+var _VerifyContactRequest_Code_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+// Validate checks the field values on RequestPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestPasswordResetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestPasswordResetRequestMultiError, or nil if none found.
+func (m *RequestPasswordResetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestPasswordResetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetAccount()); l < 1 || l > 255 {
+		err := RequestPasswordResetRequestValidationError{
+			field:  "Account",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestPasswordResetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestPasswordResetRequestMultiError is an error wrapping multiple
+// validation errors returned by RequestPasswordResetRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RequestPasswordResetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestPasswordResetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestPasswordResetRequestMultiError) AllErrors() []error { return m }
+
+// RequestPasswordResetRequestValidationError is the validation error returned
+// by RequestPasswordResetRequest.Validate if the designated constraints
+// aren't met.
+type RequestPasswordResetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestPasswordResetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestPasswordResetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestPasswordResetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestPasswordResetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestPasswordResetRequestValidationError) ErrorName() string {
+	return "RequestPasswordResetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestPasswordResetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestPasswordResetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestPasswordResetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestPasswordResetRequestValidationError{}
+
+// Validate checks the field values on ConfirmPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmPasswordResetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmPasswordResetRequestMultiError, or nil if none found.
+func (m *ConfirmPasswordResetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmPasswordResetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetToken()); l < 1 || l > 128 {
+		err := ConfirmPasswordResetRequestValidationError{
+			field:  "Token",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetNewPassword()); l < 1 || l > 128 {
+		err := ConfirmPasswordResetRequestValidationError{
+			field:  "NewPassword",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConfirmPasswordResetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmPasswordResetRequestMultiError is an error wrapping multiple
+// validation errors returned by ConfirmPasswordResetRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ConfirmPasswordResetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmPasswordResetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmPasswordResetRequestMultiError) AllErrors() []error { return m }
+
+// ConfirmPasswordResetRequestValidationError is the validation error returned
+// by ConfirmPasswordResetRequest.Validate if the designated constraints
+// aren't met.
+type ConfirmPasswordResetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmPasswordResetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmPasswordResetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmPasswordResetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmPasswordResetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmPasswordResetRequestValidationError) ErrorName() string {
+	return "ConfirmPasswordResetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmPasswordResetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmPasswordResetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmPasswordResetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmPasswordResetRequestValidationError{}
+
+// Validate checks the field values on EnrollMfaResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EnrollMfaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollMfaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrollMfaResponseMultiError, or nil if none found.
+func (m *EnrollMfaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollMfaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Secret
+
+	// no validation rules for Uri
+
+	if len(errors) > 0 {
+		return EnrollMfaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollMfaResponseMultiError is an error wrapping multiple validation errors
+// returned by EnrollMfaResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EnrollMfaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollMfaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollMfaResponseMultiError) AllErrors() []error { return m }
+
+// EnrollMfaResponseValidationError is the validation error returned by
+// EnrollMfaResponse.Validate if the designated constraints aren't met.
+type EnrollMfaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollMfaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollMfaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollMfaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollMfaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollMfaResponseValidationError) ErrorName() string {
+	return "EnrollMfaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrollMfaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollMfaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollMfaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollMfaResponseValidationError{}
+
+// Validate checks the field values on ConfirmMfaRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmMfaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmMfaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmMfaRequestMultiError, or nil if none found.
+func (m *ConfirmMfaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmMfaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ConfirmMfaRequest_Code_Pattern.MatchString(m.GetCode()) {
+		err := ConfirmMfaRequestValidationError{
+			field:  "Code",
+			reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConfirmMfaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmMfaRequestMultiError is an error wrapping multiple validation errors
+// returned by ConfirmMfaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ConfirmMfaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmMfaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmMfaRequestMultiError) AllErrors() []error { return m }
+
+// ConfirmMfaRequestValidationError is the validation error returned by
+// ConfirmMfaRequest.Validate if the designated constraints aren't met.
+type ConfirmMfaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmMfaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmMfaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmMfaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmMfaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmMfaRequestValidationError) ErrorName() string {
+	return "ConfirmMfaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmMfaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmMfaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmMfaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmMfaRequestValidationError{}
+
+var _ConfirmMfaRequest_Code_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+// Validate checks the field values on ConfirmMfaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmMfaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmMfaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmMfaResponseMultiError, or nil if none found.
+func (m *ConfirmMfaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmMfaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ConfirmMfaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmMfaResponseMultiError is an error wrapping multiple validation errors
+// returned by ConfirmMfaResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ConfirmMfaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmMfaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmMfaResponseMultiError) AllErrors() []error { return m }
+
+// ConfirmMfaResponseValidationError is the validation error returned by
+// ConfirmMfaResponse.Validate if the designated constraints aren't met.
+type ConfirmMfaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmMfaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmMfaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmMfaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmMfaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmMfaResponseValidationError) ErrorName() string {
+	return "ConfirmMfaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmMfaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmMfaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmMfaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmMfaResponseValidationError{}
+
+// Validate checks the field values on VerifyMfaRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VerifyMfaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyMfaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyMfaRequestMultiError, or nil if none found.
+func (m *VerifyMfaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyMfaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetMfaToken()); l < 1 || l > 128 {
+		err := VerifyMfaRequestValidationError{
+			field:  "MfaToken",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 6 || l > 32 {
+		err := VerifyMfaRequestValidationError{
+			field:  "Code",
+			reason: "value length must be between 6 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VerifyMfaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyMfaRequestMultiError is an error wrapping multiple validation errors
+// returned by VerifyMfaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type VerifyMfaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyMfaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyMfaRequestMultiError) AllErrors() []error { return m }
+
+// VerifyMfaRequestValidationError is the validation error returned by
+// VerifyMfaRequest.Validate if the designated constraints aren't met.
+type VerifyMfaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyMfaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyMfaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyMfaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyMfaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyMfaRequestValidationError) ErrorName() string { return "VerifyMfaRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VerifyMfaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyMfaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyMfaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyMfaRequestValidationError{}
+
+// Validate checks the field values on ResetMfaRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResetMfaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetMfaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetMfaRequestMultiError, or nil if none found.
+func (m *ResetMfaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetMfaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 1 {
+		err := ResetMfaRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResetMfaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetMfaRequestMultiError is an error wrapping multiple validation errors
+// returned by ResetMfaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ResetMfaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetMfaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetMfaRequestMultiError) AllErrors() []error { return m }
+
+// ResetMfaRequestValidationError is the validation error returned by
+// ResetMfaRequest.Validate if the designated constraints aren't met.
+type ResetMfaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetMfaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetMfaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetMfaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetMfaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetMfaRequestValidationError) ErrorName() string { return "ResetMfaRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResetMfaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetMfaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetMfaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetMfaRequestValidationError{}
+
+// Validate checks the field values on OrderInfoRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderInfoRequestMultiError, or nil if none found.
+func (m *OrderInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() < 1 {
+		err := OrderInfoRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OrderInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderInfoRequestMultiError is an error wrapping multiple validation errors
+// returned by OrderInfoRequest.ValidateAll() if the designated constraints
+// aren't met.
+type OrderInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderInfoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderInfoRequestMultiError) AllErrors() []error { return m }
+
+// OrderInfoRequestValidationError is the validation error returned by
+// OrderInfoRequest.Validate if the designated constraints aren't met.
+type OrderInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderInfoRequestValidationError) ErrorName() string { return "OrderInfoRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderInfoRequestValidationError{}
+
+// Validate checks the field values on OrderInfoResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderInfoResponseMultiError, or nil if none found.
+func (m *OrderInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUserInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderInfoResponseValidationError{
+					field:  "UserInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderInfoResponseValidationError{
+					field:  "UserInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUserInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderInfoResponseValidationError{
+				field:  "UserInfo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOrderInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderInfoResponseValidationError{
+					field:  "OrderInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderInfoResponseValidationError{
+					field:  "OrderInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
 				})
 			}
-		case interface{ Validate() error }:
+		}
+	} else if v, ok := interface{}(m.GetOrderInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderInfoResponseValidationError{
+				field:  "OrderInfo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetProductList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderInfoResponseValidationError{
+						field:  fmt.Sprintf("ProductList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderInfoResponseValidationError{
+						field:  fmt.Sprintf("ProductList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderInfoResponseValidationError{
+					field:  fmt.Sprintf("ProductList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OrderInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderInfoResponseMultiError is an error wrapping multiple validation errors
+// returned by OrderInfoResponse.ValidateAll() if the designated constraints
+// aren't met.
+type OrderInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderInfoResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderInfoResponseMultiError) AllErrors() []error { return m }
+
+// OrderInfoResponseValidationError is the validation error returned by
+// OrderInfoResponse.Validate if the designated constraints aren't met.
+type OrderInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderInfoResponseValidationError) ErrorName() string {
+	return "OrderInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderInfoResponseValidationError{}
+
+// Validate checks the field values on ListSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSessionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSessionsResponseMultiError, or nil if none found.
+func (m *ListSessionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSessionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSessionsResponseValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSessionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSessionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSessionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSessionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSessionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSessionsResponseMultiError) AllErrors() []error { return m }
+
+// ListSessionsResponseValidationError is the validation error returned by
+// ListSessionsResponse.Validate if the designated constraints aren't met.
+type ListSessionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSessionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSessionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSessionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSessionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSessionsResponseValidationError) ErrorName() string {
+	return "ListSessionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSessionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSessionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSessionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSessionsResponseValidationError{}
+
+// Validate checks the field values on RevokeSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSessionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSessionsResponseMultiError, or nil if none found.
+func (m *RevokeSessionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSessionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Revoked
+
+	if len(errors) > 0 {
+		return RevokeSessionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSessionsResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeSessionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSessionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSessionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSessionsResponseMultiError) AllErrors() []error { return m }
+
+// RevokeSessionsResponseValidationError is the validation error returned by
+// RevokeSessionsResponse.Validate if the designated constraints aren't met.
+type RevokeSessionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSessionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSessionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSessionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSessionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSessionsResponseValidationError) ErrorName() string {
+	return "RevokeSessionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSessionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSessionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSessionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSessionsResponseValidationError{}
+
+// Validate checks the field values on ListRolesResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRolesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRolesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRolesResponseMultiError, or nil if none found.
+func (m *ListRolesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRolesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRolesResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRolesResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRolesResponseValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRolesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRolesResponseMultiError is an error wrapping multiple validation errors
+// returned by ListRolesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListRolesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRolesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRolesResponseMultiError) AllErrors() []error { return m }
+
+// ListRolesResponseValidationError is the validation error returned by
+// ListRolesResponse.Validate if the designated constraints aren't met.
+type ListRolesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRolesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRolesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRolesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRolesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRolesResponseValidationError) ErrorName() string {
+	return "ListRolesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRolesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRolesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRolesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRolesResponseValidationError{}
+
+// Validate checks the field values on SaveRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SaveRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SaveRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SaveRoleRequestMultiError, or nil if none found.
+func (m *SaveRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SaveRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 50 {
+		err := SaveRoleRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SaveRoleRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := SaveRoleRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_:-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 255 {
+		err := SaveRoleRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_SaveRoleRequest_Permissions_Unique := make(map[string]struct{}, len(m.GetPermissions()))
+
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if _, exists := _SaveRoleRequest_Permissions_Unique[item]; exists {
+			err := SaveRoleRequestValidationError{
+				field:  fmt.Sprintf("Permissions[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_SaveRoleRequest_Permissions_Unique[item] = struct{}{}
+		}
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 100 {
+			err := SaveRoleRequestValidationError{
+				field:  fmt.Sprintf("Permissions[%v]", idx),
+				reason: "value length must be between 1 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SaveRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SaveRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by SaveRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SaveRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SaveRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SaveRoleRequestMultiError) AllErrors() []error { return m }
+
+// SaveRoleRequestValidationError is the validation error returned by
+// SaveRoleRequest.Validate if the designated constraints aren't met.
+type SaveRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveRoleRequestValidationError) ErrorName() string { return "SaveRoleRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SaveRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveRoleRequestValidationError{}
+
+var _SaveRoleRequest_Name_Pattern = regexp.MustCompile("^[a-zA-Z0-9_:-]*$")
+
+// Validate checks the field values on DeleteRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRoleRequestMultiError, or nil if none found.
+func (m *DeleteRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeleteRoleRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteRoleRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRoleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRoleRequestValidationError is the validation error returned by
+// DeleteRoleRequest.Validate if the designated constraints aren't met.
+type DeleteRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRoleRequestValidationError) ErrorName() string {
+	return "DeleteRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRoleRequestValidationError{}
+
+// Validate checks the field values on AssignRolesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AssignRolesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssignRolesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssignRolesRequestMultiError, or nil if none found.
+func (m *AssignRolesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssignRolesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 1 {
+		err := AssignRolesRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_AssignRolesRequest_Roles_Unique := make(map[string]struct{}, len(m.GetRoles()))
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if _, exists := _AssignRolesRequest_Roles_Unique[item]; exists {
+			err := AssignRolesRequestValidationError{
+				field:  fmt.Sprintf("Roles[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_AssignRolesRequest_Roles_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for Roles[idx]
+	}
+
+	if len(errors) > 0 {
+		return AssignRolesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssignRolesRequestMultiError is an error wrapping multiple validation errors
+// returned by AssignRolesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AssignRolesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssignRolesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssignRolesRequestMultiError) AllErrors() []error { return m }
+
+// AssignRolesRequestValidationError is the validation error returned by
+// AssignRolesRequest.Validate if the designated constraints aren't met.
+type AssignRolesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssignRolesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssignRolesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssignRolesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssignRolesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssignRolesRequestValidationError) ErrorName() string {
+	return "AssignRolesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AssignRolesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssignRolesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssignRolesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssignRolesRequestValidationError{}
+
+// Validate checks the field values on ListUsersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsersRequestMultiError, or nil if none found.
+func (m *ListUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 0 {
+		err := ListUsersRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListUsersRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKeyword()) > 50 {
+		err := ListUsersRequestValidationError{
+			field:  "Keyword",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ListUsersRequest_IsDisable_InLookup[m.GetIsDisable()]; !ok {
+		err := ListUsersRequestValidationError{
+			field:  "IsDisable",
+			reason: "value must be in list [0 1 2]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) > 50 {
+		err := ListUsersRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsersRequestMultiError is an error wrapping multiple validation errors
+// returned by ListUsersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsersRequestMultiError) AllErrors() []error { return m }
+
+// ListUsersRequestValidationError is the validation error returned by
+// ListUsersRequest.Validate if the designated constraints aren't met.
+type ListUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsersRequestValidationError) ErrorName() string { return "ListUsersRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsersRequestValidationError{}
+
+var _ListUsersRequest_IsDisable_InLookup = map[int64]struct{}{
+	0: {},
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on ListUsersResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListUsersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsersResponseMultiError, or nil if none found.
+func (m *ListUsersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUsersResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUsersResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, OrderInfoResponseValidationError{
-					field:  "UserInfo",
+				return ListUsersResponseValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetUserInfo()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return OrderInfoResponseValidationError{
-				field:  "UserInfo",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListUsersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsersResponseMultiError is an error wrapping multiple validation errors
+// returned by ListUsersResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListUsersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsersResponseMultiError) AllErrors() []error { return m }
+
+// ListUsersResponseValidationError is the validation error returned by
+// ListUsersResponse.Validate if the designated constraints aren't met.
+type ListUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUsersResponseValidationError) ErrorName() string {
+	return "ListUsersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUsersResponseValidationError{}
+
+// Validate checks the field values on GetUserRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserRequestMultiError,
+// or nil if none found.
+func (m *GetUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 1 {
+		err := GetUserRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserRequestMultiError is an error wrapping multiple validation errors
+// returned by GetUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserRequestMultiError) AllErrors() []error { return m }
+
+// GetUserRequestValidationError is the validation error returned by
+// GetUserRequest.Validate if the designated constraints aren't met.
+type GetUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserRequestValidationError) ErrorName() string { return "GetUserRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserRequestValidationError{}
+
+// Validate checks the field values on SetUserStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetUserStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetUserStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetUserStatusRequestMultiError, or nil if none found.
+func (m *SetUserStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetUserStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 1 {
+		err := SetUserStatusRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SetUserStatusRequest_IsDisable_InLookup[m.GetIsDisable()]; !ok {
+		err := SetUserStatusRequestValidationError{
+			field:  "IsDisable",
+			reason: "value must be in list [1 2]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetUserStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetUserStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by SetUserStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetUserStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetUserStatusRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetUserStatusRequestMultiError) AllErrors() []error { return m }
+
+// SetUserStatusRequestValidationError is the validation error returned by
+// SetUserStatusRequest.Validate if the designated constraints aren't met.
+type SetUserStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetUserStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetUserStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetUserStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetUserStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetUserStatusRequestValidationError) ErrorName() string {
+	return "SetUserStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetUserStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetUserStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetUserStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetUserStatusRequestValidationError{}
+
+var _SetUserStatusRequest_IsDisable_InLookup = map[int64]struct{}{
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on DeleteUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteUserRequestMultiError, or nil if none found.
+func (m *DeleteUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() < 1 {
+		err := DeleteUserRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteUserRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteUserRequestMultiError) AllErrors() []error { return m }
+
+// DeleteUserRequestValidationError is the validation error returned by
+// DeleteUserRequest.Validate if the designated constraints aren't met.
+type DeleteUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteUserRequestValidationError) ErrorName() string {
+	return "DeleteUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteUserRequestValidationError{}
+
+// Validate checks the field values on UserDetail with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserDetail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserDetail with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserDetailMultiError, or
+// nil if none found.
+func (m *UserDetail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserDetail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UserDetailMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserDetailMultiError is an error wrapping multiple validation errors
+// returned by UserDetail.ValidateAll() if the designated constraints aren't met.
+type UserDetailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserDetailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	if all {
-		switch v := interface{}(m.GetOrderInfo()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrderInfoResponseValidationError{
-					field:  "OrderInfo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, OrderInfoResponseValidationError{
-					field:  "OrderInfo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOrderInfo()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return OrderInfoResponseValidationError{
-				field:  "OrderInfo",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// AllErrors returns a list of validation violation errors.
+func (m UserDetailMultiError) AllErrors() []error { return m }
+
+// UserDetailValidationError is the validation error returned by
+// UserDetail.Validate if the designated constraints aren't met.
+type UserDetailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserDetailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserDetailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserDetailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserDetailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserDetailValidationError) ErrorName() string { return "UserDetailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserDetailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserDetail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserDetailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserDetailValidationError{}
+
+// Validate checks the field values on SessionDetail with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SessionDetail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SessionDetail with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SessionDetailMultiError, or
+// nil if none found.
+func (m *SessionDetail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SessionDetail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Username
+
+	// no validation rules for IssuedAt
+
+	// no validation rules for ExpireAt
+
+	// no validation rules for ClientIp
+
+	// no validation rules for UserAgent
+
+	// no validation rules for Current
+
+	if len(errors) > 0 {
+		return SessionDetailMultiError(errors)
+	}
+
+	return nil
+}
+
+// SessionDetailMultiError is an error wrapping multiple validation errors
+// returned by SessionDetail.ValidateAll() if the designated constraints
+// aren't met.
+type SessionDetailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SessionDetailMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SessionDetailMultiError) AllErrors() []error { return m }
+
+// SessionDetailValidationError is the validation error returned by
+// SessionDetail.Validate if the designated constraints aren't met.
+type SessionDetailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SessionDetailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SessionDetailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SessionDetailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SessionDetailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SessionDetailValidationError) ErrorName() string { return "SessionDetailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SessionDetailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSessionDetail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SessionDetailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SessionDetailValidationError{}
+
+// Validate checks the field values on AdminUserDetail with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserDetail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserDetail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserDetailMultiError, or nil if none found.
+func (m *AdminUserDetail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserDetail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetProductInfo()).(type) {
+		switch v := interface{}(m.GetInfo()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrderInfoResponseValidationError{
-					field:  "ProductInfo",
+				errors = append(errors, AdminUserDetailValidationError{
+					field:  "Info",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, OrderInfoResponseValidationError{
-					field:  "ProductInfo",
+				errors = append(errors, AdminUserDetailValidationError{
+					field:  "Info",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetProductInfo()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return OrderInfoResponseValidationError{
-				field:  "ProductInfo",
+			return AdminUserDetailValidationError{
+				field:  "Info",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for MfaEnabled
+
 	if len(errors) > 0 {
-		return OrderInfoResponseMultiError(errors)
+		return AdminUserDetailMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrderInfoResponseMultiError is an error wrapping multiple validation errors
-// returned by OrderInfoResponse.ValidateAll() if the designated constraints
+// AdminUserDetailMultiError is an error wrapping multiple validation errors
+// returned by AdminUserDetail.ValidateAll() if the designated constraints
 // aren't met.
-type OrderInfoResponseMultiError []error
+type AdminUserDetailMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrderInfoResponseMultiError) Error() string {
+func (m AdminUserDetailMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1119,11 +4461,11 @@ func (m OrderInfoResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrderInfoResponseMultiError) AllErrors() []error { return m }
+func (m AdminUserDetailMultiError) AllErrors() []error { return m }
 
-// OrderInfoResponseValidationError is the validation error returned by
-// OrderInfoResponse.Validate if the designated constraints aren't met.
-type OrderInfoResponseValidationError struct {
+// AdminUserDetailValidationError is the validation error returned by
+// AdminUserDetail.Validate if the designated constraints aren't met.
+type AdminUserDetailValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1131,24 +4473,22 @@ type OrderInfoResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrderInfoResponseValidationError) Field() string { return e.field }
+func (e AdminUserDetailValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrderInfoResponseValidationError) Reason() string { return e.reason }
+func (e AdminUserDetailValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrderInfoResponseValidationError) Cause() error { return e.cause }
+func (e AdminUserDetailValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrderInfoResponseValidationError) Key() bool { return e.key }
+func (e AdminUserDetailValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrderInfoResponseValidationError) ErrorName() string {
-	return "OrderInfoResponseValidationError"
-}
+func (e AdminUserDetailValidationError) ErrorName() string { return "AdminUserDetailValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OrderInfoResponseValidationError) Error() string {
+func (e AdminUserDetailValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1160,14 +4500,14 @@ func (e OrderInfoResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrderInfoResponse.%s: %s%s",
+		"invalid %sAdminUserDetail.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrderInfoResponseValidationError{}
+var _ error = AdminUserDetailValidationError{}
 
 var _ interface {
 	Field() string
@@ -1175,43 +4515,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrderInfoResponseValidationError{}
+} = AdminUserDetailValidationError{}
 
-// Validate checks the field values on UserDetail with the rules defined in the
+// Validate checks the field values on RoleDetail with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *UserDetail) Validate() error {
+func (m *RoleDetail) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserDetail with the rules defined in
+// ValidateAll checks the field values on RoleDetail with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UserDetailMultiError, or
+// result is a list of violation errors wrapped in RoleDetailMultiError, or
 // nil if none found.
-func (m *UserDetail) ValidateAll() error {
+func (m *RoleDetail) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserDetail) validate(all bool) error {
+func (m *RoleDetail) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for CreateTime
+
+	// no validation rules for UpdateTime
+
 	if len(errors) > 0 {
-		return UserDetailMultiError(errors)
+		return RoleDetailMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserDetailMultiError is an error wrapping multiple validation errors
-// returned by UserDetail.ValidateAll() if the designated constraints aren't met.
-type UserDetailMultiError []error
+// RoleDetailMultiError is an error wrapping multiple validation errors
+// returned by RoleDetail.ValidateAll() if the designated constraints aren't met.
+type RoleDetailMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserDetailMultiError) Error() string {
+func (m RoleDetailMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1220,11 +4570,11 @@ func (m UserDetailMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserDetailMultiError) AllErrors() []error { return m }
+func (m RoleDetailMultiError) AllErrors() []error { return m }
 
-// UserDetailValidationError is the validation error returned by
-// UserDetail.Validate if the designated constraints aren't met.
-type UserDetailValidationError struct {
+// RoleDetailValidationError is the validation error returned by
+// RoleDetail.Validate if the designated constraints aren't met.
+type RoleDetailValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1232,22 +4582,22 @@ type UserDetailValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserDetailValidationError) Field() string { return e.field }
+func (e RoleDetailValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserDetailValidationError) Reason() string { return e.reason }
+func (e RoleDetailValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserDetailValidationError) Cause() error { return e.cause }
+func (e RoleDetailValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserDetailValidationError) Key() bool { return e.key }
+func (e RoleDetailValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserDetailValidationError) ErrorName() string { return "UserDetailValidationError" }
+func (e RoleDetailValidationError) ErrorName() string { return "RoleDetailValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UserDetailValidationError) Error() string {
+func (e RoleDetailValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1259,14 +4609,14 @@ func (e UserDetailValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserDetail.%s: %s%s",
+		"invalid %sRoleDetail.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserDetailValidationError{}
+var _ error = RoleDetailValidationError{}
 
 var _ interface {
 	Field() string
@@ -1274,7 +4624,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserDetailValidationError{}
+} = RoleDetailValidationError{}
 
 // Validate checks the field values on Response with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -1444,10 +4794,6 @@ func (m *UserDetail_Detail) validate(all bool) error {
 
 	// no validation rules for IsDisable
 
-	// no validation rules for AccessToken
-
-	// no validation rules for AccessTokenExpireTime
-
 	// no validation rules for NickName
 
 	// no validation rules for RealName
@@ -1456,6 +4802,10 @@ func (m *UserDetail_Detail) validate(all bool) error {
 
 	// no validation rules for UpdateTime
 
+	// no validation rules for EmailVerified
+
+	// no validation rules for PhoneVerified
+
 	if len(errors) > 0 {
 		return UserDetail_DetailMultiError(errors)
 	}
@@ -0,0 +1,97 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+const (
+	// ChannelEmail 邮件
+	ChannelEmail = "email"
+	// ChannelPhone 短信
+	ChannelPhone = "phone"
+)
+
+// Message 通知内容
+type Message struct {
+	// Channel 发送渠道[email/phone]
+	Channel string `json:"channel"`
+	// To 邮箱地址或者手机号
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// Notifier 通知发送接口。接入邮件或者短信服务时实现该接口
+type Notifier interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// LogNotifier 把通知内容写到日志。只用于本地开发
+type LogNotifier struct {
+	logger log.Logger
+}
+
+// NewLogNotifier 实例化 LogNotifier
+func NewLogNotifier(logger log.Logger) *LogNotifier {
+	return &LogNotifier{logger: logger}
+}
+
+// Send 发送通知
+func (n *LogNotifier) Send(_ context.Context, msg *Message) error {
+	return level.Info(n.logger).Log(
+		"msg", "发送通知",
+		"channel", msg.Channel,
+		"to", msg.To,
+		"subject", msg.Subject,
+		"body", msg.Body,
+	)
+}
+
+// FileNotifier 把通知内容以 JSON 行追加写入文件。只用于本地开发以及联调
+type FileNotifier struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileNotifier 实例化 FileNotifier
+func NewFileNotifier(path string) (*FileNotifier, error) {
+
+	if path == "" {
+		return nil, errors.New("notifier file can not be empty")
+	}
+	return &FileNotifier{path: path}, nil
+
+}
+
+// Send 发送通知
+func (n *FileNotifier) Send(_ context.Context, msg *Message) error {
+
+	data, err := json.Marshal(struct {
+		*Message
+		SentAt int64 `json:"sent_at"`
+	}{msg, time.Now().Unix()})
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	f, err := os.OpenFile(n.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(append(data, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+
+}
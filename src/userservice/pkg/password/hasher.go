@@ -0,0 +1,179 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// AlgorithmBcrypt bcrypt
+	AlgorithmBcrypt = "bcrypt"
+	// AlgorithmArgon2id argon2id
+	AlgorithmArgon2id = "argon2id"
+)
+
+// ErrUnknownHash 无法识别的密码哈希格式
+var ErrUnknownHash = errors.New("unknown password hash format")
+
+// Argon2Params argon2id 参数
+type Argon2Params struct {
+	// Memory 内存大小，单位 KiB
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// Hasher 密码哈希。使用配置的算法生成哈希，同时可以验证所有支持的算法生成的哈希
+type Hasher struct {
+	algorithm  string
+	bcryptCost int
+	argon2     Argon2Params
+	// dummy 用户不存在时用于比较的哈希，让失败耗时与密码错误一致
+	dummy string
+}
+
+// NewHasher 实例化 Hasher
+func NewHasher(algorithm string, bcryptCost int, argon2 Argon2Params) (*Hasher, error) {
+
+	switch algorithm {
+	case AlgorithmBcrypt:
+		if bcryptCost < bcrypt.MinCost || bcryptCost > bcrypt.MaxCost {
+			return nil, fmt.Errorf("bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+	case AlgorithmArgon2id:
+		if argon2.Memory == 0 || argon2.Iterations == 0 || argon2.Parallelism == 0 {
+			return nil, errors.New("argon2id memory, iterations and parallelism can not be zero")
+		}
+		if argon2.SaltLength == 0 {
+			argon2.SaltLength = 16
+		}
+		if argon2.KeyLength == 0 {
+			argon2.KeyLength = 32
+		}
+	default:
+		return nil, errors.New("unsupported password hash algorithm " + algorithm)
+	}
+
+	h := &Hasher{algorithm: algorithm, bcryptCost: bcryptCost, argon2: argon2}
+	dummy, err := h.Hash("dummy-password")
+	if err != nil {
+		return nil, err
+	}
+	h.dummy = dummy
+	return h, nil
+
+}
+
+// Hash 使用配置的算法生成密码哈希
+func (h *Hasher) Hash(password string) (string, error) {
+
+	if h.algorithm == AlgorithmBcrypt {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), h.bcryptCost)
+		return string(hash), err
+	}
+
+	salt := make([]byte, h.argon2.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	p := h.argon2
+	key := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, p.KeyLength)
+	return fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, p.Memory, p.Iterations, p.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+
+}
+
+// Verify 验证密码是否与哈希匹配。hash 为空时与内置的哈希比较，结果总是不匹配
+func (h *Hasher) Verify(hash string, password string) (bool, error) {
+
+	if hash == "" {
+		_, _ = h.Verify(h.dummy, password)
+		return false, nil
+	}
+	if strings.HasPrefix(hash, "$argon2id$") {
+		p, salt, key, err := decodeArgon2(hash)
+		if err != nil {
+			return false, err
+		}
+		other := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, p.KeyLength)
+		return subtle.ConstantTimeCompare(key, other) == 1, nil
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	if err != nil {
+		return false, ErrUnknownHash
+	}
+	return true, nil
+
+}
+
+// NeedsRehash 哈希的算法或者参数与当前配置不一致时需要重新生成
+func (h *Hasher) NeedsRehash(hash string) bool {
+
+	if strings.HasPrefix(hash, "$argon2id$") {
+		if h.algorithm != AlgorithmArgon2id {
+			return true
+		}
+		p, salt, _, err := decodeArgon2(hash)
+		if err != nil {
+			return true
+		}
+		return p.Memory != h.argon2.Memory ||
+			p.Iterations != h.argon2.Iterations ||
+			p.Parallelism != h.argon2.Parallelism ||
+			p.KeyLength != h.argon2.KeyLength ||
+			uint32(len(salt)) != h.argon2.SaltLength
+	}
+
+	if h.algorithm != AlgorithmBcrypt {
+		return true
+	}
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost != h.bcryptCost
+
+}
+
+// decodeArgon2 解析 $argon2id$v=19$m=65536,t=3,p=2$salt$key 格式的哈希
+func decodeArgon2(hash string) (Argon2Params, []byte, []byte, error) {
+
+	var p Argon2Params
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return p, nil, nil, ErrUnknownHash
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, ErrUnknownHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Iterations, &p.Parallelism); err != nil {
+		return p, nil, nil, ErrUnknownHash
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return p, nil, nil, ErrUnknownHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return p, nil, nil, ErrUnknownHash
+	}
+	p.SaltLength = uint32(len(salt))
+	p.KeyLength = uint32(len(key))
+	return p, salt, key, nil
+
+}
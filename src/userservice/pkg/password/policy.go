@@ -0,0 +1,103 @@
+package password
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrPolicy 密码不符合密码策略。具体原因使用 errors.Is 判断后直接返回给用户
+var ErrPolicy = errors.New("密码不符合要求")
+
+// Policy 密码策略
+type Policy struct {
+	minLength  int
+	maxLength  int
+	minClasses int
+	blocklist  map[string]struct{}
+}
+
+// NewPolicy 实例化 Policy
+// minClasses 为至少需要包含的字符种类数量，字符种类为大写字母、小写字母、数字以及其他符号
+// blocklistFile 为常见密码或者已泄露密码列表文件，每行一个，为空时不检查
+func NewPolicy(minLength int, maxLength int, minClasses int, blocklistFile string) (*Policy, error) {
+
+	if maxLength > 0 && maxLength < minLength {
+		return nil, errors.New("password maxLength can not be less than minLength")
+	}
+	p := &Policy{
+		minLength:  minLength,
+		maxLength:  maxLength,
+		minClasses: minClasses,
+		blocklist:  map[string]struct{}{},
+	}
+	if blocklistFile == "" {
+		return p, nil
+	}
+
+	f, err := os.Open(blocklistFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" && !strings.HasPrefix(line, "#") {
+			p.blocklist[strings.ToLower(line)] = struct{}{}
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	return p, nil
+
+}
+
+// Validate 检查密码是否符合策略。username 不为空时密码不能包含用户名
+func (p *Policy) Validate(password string, username string) error {
+
+	length := utf8.RuneCountInString(password)
+	if length < p.minLength {
+		return fmt.Errorf("%w：长度不能少于 %d 位", ErrPolicy, p.minLength)
+	}
+	if p.maxLength > 0 && length > p.maxLength {
+		return fmt.Errorf("%w：长度不能超过 %d 位", ErrPolicy, p.maxLength)
+	}
+
+	var upper, lower, digit, other bool
+	for _, c := range password {
+		switch {
+		case unicode.IsUpper(c):
+			upper = true
+		case unicode.IsLower(c):
+			lower = true
+		case unicode.IsDigit(c):
+			digit = true
+		default:
+			other = true
+		}
+	}
+	classes := 0
+	for _, v := range []bool{upper, lower, digit, other} {
+		if v {
+			classes++
+		}
+	}
+	if classes < p.minClasses {
+		return fmt.Errorf("%w：至少需要包含大写字母、小写字母、数字、符号中的 %d 种", ErrPolicy, p.minClasses)
+	}
+
+	lowered := strings.ToLower(password)
+	if username != "" && strings.Contains(lowered, strings.ToLower(username)) {
+		return fmt.Errorf("%w：不能包含用户名", ErrPolicy)
+	}
+	if _, ok := p.blocklist[lowered]; ok {
+		return fmt.Errorf("%w：密码过于常见", ErrPolicy)
+	}
+	return nil
+
+}
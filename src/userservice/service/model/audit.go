@@ -0,0 +1,43 @@
+package model
+
+import (
+	"gorm.io/gorm"
+)
+
+// MigrateAuditLogTable Migrate audit log table
+func MigrateAuditLogTable(mysqlDB *gorm.DB) {
+
+	m := mysqlDB.Migrator()
+	if !m.HasTable(&AuditLog{}) {
+		if err := m.CreateTable(&AuditLog{}); err != nil {
+			panic("migrate Failed.[ERROR]=>create audit_log table failed.")
+		}
+		mysqlDB.Exec("ALTER TABLE `audit_log` COMMENT 'admin audit log table'")
+	}
+
+}
+
+// AuditLog AuditLog Table
+type AuditLog struct {
+	// primary id
+	ID int64 `json:"id" gorm:"column:id;primaryKey;type:int(10);unique;autoIncrement;comment:primary id"`
+	// operator
+	OperatorID   int64  `json:"operator_id" gorm:"column:operator_id;index:idx_operator_id;type:int(10);not null;comment:operator user id"`
+	OperatorName string `json:"operator_name" gorm:"column:operator_name;type:varchar(20);default:'';comment:operator username"`
+	// action
+	Action string `json:"action" gorm:"column:action;index:idx_action;type:varchar(50);default:'';not null;comment:action"`
+	// target
+	TargetType string `json:"target_type" gorm:"column:target_type;index:idx_target;type:varchar(20);default:'';comment:target type[user/role]"`
+	TargetID   string `json:"target_id" gorm:"column:target_id;index:idx_target;type:varchar(50);default:'';comment:target id"`
+	// detail
+	Detail string `json:"detail" gorm:"column:detail;type:text;comment:request detail in json"`
+	// client ip
+	ClientIP string `json:"client_ip" gorm:"column:client_ip;type:varchar(64);default:'';comment:client ip"`
+	// create_time
+	CreateTime int64 `json:"create_time" gorm:"column:create_time;type:int(10);default:0;comment:create time'"`
+}
+
+// TableName Table Name
+func (*AuditLog) TableName() string {
+	return "audit_log"
+}
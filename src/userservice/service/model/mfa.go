@@ -0,0 +1,48 @@
+package model
+
+import (
+	"gorm.io/gorm"
+)
+
+// MigrateUserMfaTable Migrate user mfa table
+func MigrateUserMfaTable(mysqlDB *gorm.DB) {
+
+	m := mysqlDB.Migrator()
+	if !m.HasTable(&UserMfa{}) {
+		if err := m.CreateTable(&UserMfa{}); err != nil {
+			panic("migrate Failed.[ERROR]=>create user_mfa table failed.")
+		}
+		mysqlDB.Exec("ALTER TABLE `user_mfa` COMMENT 'user mfa table'")
+	}
+
+}
+
+// is_enabled 字段取值
+const (
+	// MfaEnabled 已经确认并开启
+	MfaEnabled int64 = 1
+	// MfaPending 已经生成密钥，等待用户确认
+	MfaPending int64 = 2
+)
+
+// UserMfa UserMfa Table
+type UserMfa struct {
+	// primary id
+	ID int64 `json:"id" gorm:"column:id;primaryKey;type:int(10);unique;autoIncrement;comment:primary id"`
+	// user id
+	UserID int64 `json:"user_id" gorm:"column:user_id;uniqueIndex:idx_user_id;type:int(10);not null;comment:user id"`
+	// totp secret
+	Secret string `json:"secret" gorm:"column:secret;type:varchar(64);default:'';not null;comment:totp secret"`
+	// is_enabled
+	IsEnabled int64 `json:"is_enabled" gorm:"column:is_enabled;type:tinyint(1);default:2;not null;comment:is_enabled[1=enabled2=pending]"`
+	// recovery codes
+	RecoveryCodes string `json:"recovery_codes" gorm:"column:recovery_codes;type:varchar(1024);default:'';comment:sha256 of recovery codes separated by comma"`
+	//create_time / update_time
+	CreateTime int64 `json:"create_time" gorm:"column:create_time;type:int(10);default:0;comment:create time'"`
+	UpdateTime int64 `json:"update_time" gorm:"column:update_time;type:int(10);default:0;comment:update time"`
+}
+
+// TableName Table Name
+func (*UserMfa) TableName() string {
+	return "user_mfa"
+}
@@ -6,5 +6,9 @@ import (
 
 // Migrate 迁移表格
 func Migrate(mysqlDB *gorm.DB) {
-	MigrateUserTable(mysqlDB) // Migrate user table
+	MigrateUserTable(mysqlDB)     // Migrate user table
+	MigrateRoleTable(mysqlDB)     // Migrate role table
+	MigrateUserRoleTable(mysqlDB) // Migrate user role table
+	MigrateUserMfaTable(mysqlDB)  // Migrate user mfa table
+	MigrateAuditLogTable(mysqlDB) // Migrate audit log table
 }
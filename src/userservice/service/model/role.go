@@ -0,0 +1,68 @@
+package model
+
+import (
+	"gorm.io/gorm"
+)
+
+// MigrateRoleTable Migrate role table
+func MigrateRoleTable(mysqlDB *gorm.DB) {
+
+	m := mysqlDB.Migrator()
+	if !m.HasTable(&Role{}) {
+		if err := m.CreateTable(&Role{}); err != nil {
+			panic("migrate Failed.[ERROR]=>create role table failed.")
+		}
+		mysqlDB.Exec("ALTER TABLE `role` COMMENT 'role table'")
+	}
+
+}
+
+// MigrateUserRoleTable Migrate user role table
+func MigrateUserRoleTable(mysqlDB *gorm.DB) {
+
+	m := mysqlDB.Migrator()
+	if !m.HasTable(&UserRole{}) {
+		if err := m.CreateTable(&UserRole{}); err != nil {
+			panic("migrate Failed.[ERROR]=>create user_role table failed.")
+		}
+		mysqlDB.Exec("ALTER TABLE `user_role` COMMENT 'user role table'")
+	}
+
+}
+
+// Role Role Table
+type Role struct {
+	// primary id
+	ID int64 `json:"id" gorm:"column:id;primaryKey;type:int(10);unique;autoIncrement;comment:primary id"`
+	// name
+	Name string `json:"name" gorm:"column:name;uniqueIndex:idx_name;type:varchar(50);default:'';not null;comment:role name"`
+	// description
+	Description string `json:"description" gorm:"column:description;type:varchar(255);default:'';comment:description"`
+	// permissions
+	Permissions string `json:"permissions" gorm:"column:permissions;type:varchar(1024);default:'';comment:permissions separated by comma"`
+	//create_time / update_time
+	CreateTime int64 `json:"create_time" gorm:"column:create_time;type:int(10);default:0;comment:create time'"`
+	UpdateTime int64 `json:"update_time" gorm:"column:update_time;type:int(10);default:0;comment:update time"`
+}
+
+// TableName Table Name
+func (*Role) TableName() string {
+	return "role"
+}
+
+// UserRole UserRole Table
+type UserRole struct {
+	// primary id
+	ID int64 `json:"id" gorm:"column:id;primaryKey;type:int(10);unique;autoIncrement;comment:primary id"`
+	// user id
+	UserID int64 `json:"user_id" gorm:"column:user_id;uniqueIndex:idx_user_role;type:int(10);not null;comment:user id"`
+	// role id
+	RoleID int64 `json:"role_id" gorm:"column:role_id;uniqueIndex:idx_user_role;index:idx_role_id;type:int(10);not null;comment:role id"`
+	// create_time
+	CreateTime int64 `json:"create_time" gorm:"column:create_time;type:int(10);default:0;comment:create time'"`
+}
+
+// TableName Table Name
+func (*UserRole) TableName() string {
+	return "user_role"
+}
@@ -15,8 +15,33 @@ func MigrateUserTable(mysqlDB *gorm.DB) {
 		mysqlDB.Exec("ALTER TABLE `user` COMMENT 'user table'")
 	}
 
+	// 补充后续新增的字段
+	for _, column := range []string{"EmailVerified", "PhoneVerified", "DeleteTime"} {
+		if !m.HasColumn(&User{}, column) {
+			if err := m.AddColumn(&User{}, column); err != nil {
+				panic("migrate Failed.[ERROR]=>add user column " + column + " failed.")
+			}
+		}
+	}
+
 }
 
+// is_disable 字段取值。默认值为 2，新注册的用户可以正常登录
+const (
+	// UserDisabled 账号已禁用
+	UserDisabled int64 = 1
+	// UserEnabled 账号未禁用
+	UserEnabled int64 = 2
+)
+
+// email_verified 以及 phone_verified 字段取值
+const (
+	// Verified 已验证
+	Verified int64 = 1
+	// Unverified 未验证
+	Unverified int64 = 2
+)
+
 // User User Table
 type User struct {
 	// primary id
@@ -33,6 +58,10 @@ type User struct {
 	Email string `json:"email" gorm:"column:email;type:varchar(255);default:'';comment:email"`
 	// phone
 	Phone string `json:"phone" gorm:"column:phone;type:varchar(20);default:'';comment:phone"`
+	// email_verified
+	EmailVerified int64 `json:"email_verified" gorm:"column:email_verified;type:tinyint(1);default:2;not null;comment:email_verified[1=verified2=unverified]"`
+	// phone_verified
+	PhoneVerified int64 `json:"phone_verified" gorm:"column:phone_verified;type:tinyint(1);default:2;not null;comment:phone_verified[1=verified2=unverified]"`
 	// is_disable
 	IsDisable int64 `json:"is_disable" gorm:"column:is_disable;type:tinyint(1);default:2;not null;comment:is_disable[1=enable2=disable]"`
 	// access_token
@@ -46,6 +75,8 @@ type User struct {
 	//create_time / update_time
 	CreateTime int64 `json:"create_time" gorm:"column:create_time;type:int(10);default:0;comment:create time'"`
 	UpdateTime int64 `json:"update_time" gorm:"column:update_time;type:int(10);default:0;comment:update time"`
+	// delete_time 软删除时间。为 0 时未删除
+	DeleteTime int64 `json:"delete_time" gorm:"column:delete_time;index:idx_delete_time;type:int(10);default:0;not null;comment:delete time"`
 }
 
 // TableName Table Name
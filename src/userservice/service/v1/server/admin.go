@@ -0,0 +1,277 @@
+package serverV1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"authservice/pkg/identity"
+	userPBV1 "userservice/genproto/go/v1"
+	"userservice/service/model"
+)
+
+// 审计日志操作类型
+const (
+	AuditSaveRole    = "role.save"
+	AuditDeleteRole  = "role.delete"
+	AuditAssignRoles = "user.assign_roles"
+	AuditEnableUser  = "user.enable"
+	AuditDisableUser = "user.disable"
+	AuditDeleteUser  = "user.delete"
+	AuditResetMfa    = "user.reset_mfa"
+)
+
+const (
+	// auditTargetUser 审计日志操作对象为用户
+	auditTargetUser = "user"
+	// auditTargetRole 审计日志操作对象为角色
+	auditTargetRole = "role"
+	// defaultPageSize 用户列表默认每页数量
+	defaultPageSize = 20
+	// maxPageSize 用户列表每页最大数量
+	maxPageSize = 100
+)
+
+// ErrSelfOperation 管理员不能禁用或者删除自己
+var ErrSelfOperation = errors.New("不能对自己执行该操作")
+
+// AdminUser 管理员查看的用户数据
+type AdminUser struct {
+	*model.User
+	Roles      []string
+	MfaEnabled bool
+}
+
+// audit 写入管理员操作审计日志。操作人从请求上下文中获取
+// 写入失败时只记录到 span，不影响已经完成的操作
+func (r *Repository) audit(ctx context.Context, span trace.Span, action string, targetType string, targetID string, detail any) {
+
+	entry := &model.AuditLog{
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		CreateTime: time.Now().Unix(),
+	}
+	if principal, ok := identity.FromContext(ctx); ok {
+		entry.OperatorID = principal.UserID
+		entry.OperatorName = principal.Username
+	}
+	entry.ClientIP, _ = r.clientMetadata(ctx)
+	if detail != nil {
+		data, err := json.Marshal(detail)
+		if err != nil {
+			_ = r.span.Error(span, "写入审计日志失败："+err.Error())
+			return
+		}
+		entry.Detail = string(data)
+	}
+	if result := r.mysqlDB.Table("audit_log").Create(entry); result.Error != nil {
+		_ = r.span.Error(span, "写入审计日志失败："+result.Error.Error())
+	}
+
+}
+
+// ListUsers 分页查询用户。keyword 匹配用户名、昵称、邮箱以及手机号
+func (r *Repository) ListUsers(ctx context.Context, request *userPBV1.ListUsersRequest) ([]*AdminUser, int64, error) {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	page, pageSize := int(request.Page), int(request.PageSize)
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	query := r.UserModel()
+	if keyword := strings.TrimSpace(request.Keyword); keyword != "" {
+		like := "%" + strings.NewReplacer("%", "\\%", "_", "\\_").Replace(keyword) + "%"
+		query = query.Where(r.mysqlDB.
+			Where("username LIKE ?", like).
+			Or("nick_name LIKE ?", like).
+			Or("email LIKE ?", like).
+			Or("phone LIKE ?", like))
+	}
+	if request.IsDisable != 0 {
+		query = query.Where("is_disable = ?", request.IsDisable)
+	}
+	if request.Role != "" {
+		query = query.Where("id IN (?)", r.UserRoleModel().
+			Select("user_role.user_id").
+			Joins("JOIN role ON role.id = user_role.role_id").
+			Where("role.name = ?", request.Role))
+	}
+
+	// 统计总数以及查询列表共用查询条件
+	query = query.Session(&gorm.Session{})
+	var total int64
+	if result := query.Count(&total); result.Error != nil {
+		return nil, 0, r.span.Error(span, result.Error.Error())
+	}
+	var users []*model.User
+	result := query.Order("id DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&users)
+	if result.Error != nil {
+		return nil, 0, r.span.Error(span, result.Error.Error())
+	}
+	list, err := r.adminUsers(users)
+	if err != nil {
+		return nil, 0, r.span.Error(span, err.Error())
+	}
+	return list, total, nil
+
+}
+
+// GetUser 获取用户详情
+func (r *Repository) GetUser(ctx context.Context, userID int64) (*AdminUser, error) {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	user := &model.User{}
+	if result := r.UserModel().Where("id = ?", userID).First(user); result.Error != nil {
+		_ = r.span.Error(span, result.Error.Error())
+		return nil, result.Error
+	}
+	list, err := r.adminUsers([]*model.User{user})
+	if err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	return list[0], nil
+
+}
+
+// adminUsers 批量补充用户的角色以及二次验证状态
+func (r *Repository) adminUsers(users []*model.User) ([]*AdminUser, error) {
+
+	if len(users) == 0 {
+		return nil, nil
+	}
+	ids := make([]int64, 0, len(users))
+	for _, v := range users {
+		ids = append(ids, v.ID)
+	}
+
+	var roles []struct {
+		UserID int64
+		Name   string
+	}
+	result := r.UserRoleModel().
+		Select("user_role.user_id, role.name").
+		Joins("JOIN role ON role.id = user_role.role_id").
+		Where("user_role.user_id IN ?", ids).
+		Order("role.name").
+		Scan(&roles)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	var mfaUsers []int64
+	result = r.UserMfaModel().Where("user_id IN ? AND is_enabled = ?", ids, model.MfaEnabled).Pluck("user_id", &mfaUsers)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	userRoles := make(map[int64][]string)
+	for _, v := range roles {
+		userRoles[v.UserID] = append(userRoles[v.UserID], v.Name)
+	}
+	mfaEnabled := make(map[int64]bool)
+	for _, v := range mfaUsers {
+		mfaEnabled[v] = true
+	}
+	list := make([]*AdminUser, 0, len(users))
+	for _, v := range users {
+		list = append(list, &AdminUser{User: v, Roles: userRoles[v.ID], MfaEnabled: mfaEnabled[v.ID]})
+	}
+	return list, nil
+
+}
+
+// SetUserStatus 启用或者禁用用户。禁用时同时注销用户所有登录会话
+func (r *Repository) SetUserStatus(ctx context.Context, userID int64, isDisable int64) error {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	if principal, ok := identity.FromContext(ctx); ok && principal.UserID == userID {
+		_ = r.span.Error(span, ErrSelfOperation.Error())
+		return ErrSelfOperation
+	}
+	if result := r.UserModel().Where("id = ?", userID).First(&model.User{}); result.Error != nil {
+		_ = r.span.Error(span, result.Error.Error())
+		return result.Error
+	}
+	result := r.UserModel().Where("id = ?", userID).Updates(map[string]any{
+		"is_disable":  isDisable,
+		"update_time": time.Now().Unix(),
+	})
+	if result.Error != nil {
+		return r.span.Error(span, result.Error.Error())
+	}
+
+	action := AuditEnableUser
+	if isDisable == model.UserDisabled {
+		action = AuditDisableUser
+		if _, err := r.sessions.RevokeUser(ctx, userID); err != nil {
+			return r.span.Error(span, err.Error())
+		}
+	}
+	r.audit(ctx, span, action, auditTargetUser, strconv.FormatInt(userID, 10), nil)
+	return nil
+
+}
+
+// DeleteUser 软删除用户。删除后用户无法登录，用户名继续保留，
+// 同时注销所有登录会话并清空角色
+func (r *Repository) DeleteUser(ctx context.Context, userID int64) error {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	if principal, ok := identity.FromContext(ctx); ok && principal.UserID == userID {
+		_ = r.span.Error(span, ErrSelfOperation.Error())
+		return ErrSelfOperation
+	}
+	now := time.Now().Unix()
+	err := r.mysqlDB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Table("user").Where("id = ? AND delete_time = ?", userID, 0).Updates(map[string]any{
+			"is_disable":  model.UserDisabled,
+			"delete_time": now,
+			"update_time": now,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		if err := tx.Table("user_role").Where("user_id = ?", userID).Delete(&model.UserRole{}).Error; err != nil {
+			return err
+		}
+		return tx.Table("user_mfa").Where("user_id = ?", userID).Delete(&model.UserMfa{}).Error
+	})
+	if err != nil {
+		_ = r.span.Error(span, err.Error())
+		return err
+	}
+
+	if err = r.rbac.SetUserRoles(ctx, userID, nil); err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	if _, err = r.sessions.RevokeUser(ctx, userID); err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	r.audit(ctx, span, AuditDeleteUser, auditTargetUser, strconv.FormatInt(userID, 10), nil)
+	return nil
+
+}
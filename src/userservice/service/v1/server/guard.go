@@ -0,0 +1,176 @@
+package serverV1
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"userservice/config"
+)
+
+const (
+	// loginFailedKeyPrefix 登录失败计数 key 前缀。完整 key 为 login_failed:{user|ip}:{value}
+	loginFailedKeyPrefix = "login_failed:"
+	// loginBackoffKeyPrefix 退避等待 key 前缀。key 存在期间拒绝登录
+	loginBackoffKeyPrefix = "login_backoff:"
+	// loginLockedKeyPrefix 锁定 key 前缀。key 存在期间拒绝登录
+	loginLockedKeyPrefix = "login_locked:"
+)
+
+var (
+	// ErrTooManyAttempts 处于退避等待或者锁定期间
+	ErrTooManyAttempts = errors.New("登录失败次数过多，请稍后再试")
+	// ErrAccountLocked 本次失败触发了锁定
+	ErrAccountLocked = errors.New("登录失败次数过多，已锁定")
+	// ErrUserDisabled 账号已禁用
+	ErrUserDisabled = errors.New("账号已禁用")
+	// ErrInvalidCredentials 用户名不存在或者密码错误
+	ErrInvalidCredentials = errors.New("账号或密码错误")
+)
+
+// LoginGuard 基于 redis 的登录失败计数、指数退避以及临时锁定
+type LoginGuard struct {
+	redis *redis.Client
+	conf  config.LoginGuard
+}
+
+// loginSubject 登录失败统计对象。用户名或者客户端 IP
+type loginSubject struct {
+	kind      string
+	value     string
+	threshold int64
+}
+
+// NewLoginGuard 实例化 LoginGuard
+func NewLoginGuard(redis *redis.Client, conf *config.Config) *LoginGuard {
+	return &LoginGuard{redis: redis, conf: conf.LoginGuard}
+}
+
+// subjects 需要统计的对象。获取不到客户端 IP 时只按用户名统计
+func (g *LoginGuard) subjects(username string, clientIP string) []loginSubject {
+
+	subjects := []loginSubject{{
+		kind:      "user",
+		value:     strings.ToLower(strings.TrimSpace(username)),
+		threshold: g.conf.UserLockoutThreshold,
+	}}
+	if clientIP != "" {
+		subjects = append(subjects, loginSubject{
+			kind:      "ip",
+			value:     clientIP,
+			threshold: g.conf.IPLockoutThreshold,
+		})
+	}
+	return subjects
+
+}
+
+// key 拼接 redis key
+func (s loginSubject) key(prefix string) string {
+	return prefix + s.kind + ":" + s.value
+}
+
+// Check 获取需要等待的时间。大于 0 时拒绝本次登录，不再验证密码
+func (g *LoginGuard) Check(ctx context.Context, username string, clientIP string) (time.Duration, error) {
+
+	if !g.conf.Enabled {
+		return 0, nil
+	}
+	subjects := g.subjects(username, clientIP)
+	cmds := make([]*redis.DurationCmd, 0, len(subjects)*2)
+	_, err := g.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, s := range subjects {
+			cmds = append(cmds, pipe.PTTL(ctx, s.key(loginLockedKeyPrefix)))
+			cmds = append(cmds, pipe.PTTL(ctx, s.key(loginBackoffKeyPrefix)))
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var wait time.Duration
+	for _, cmd := range cmds {
+		if ttl := cmd.Val(); ttl > wait {
+			wait = ttl
+		}
+	}
+	return wait, nil
+
+}
+
+// Fail 记录一次登录失败。失败次数达到阈值时锁定，否则按照失败次数指数退避
+// 返回本次失败是否触发了锁定
+func (g *LoginGuard) Fail(ctx context.Context, username string, clientIP string) (bool, error) {
+
+	if !g.conf.Enabled {
+		return false, nil
+	}
+	subjects := g.subjects(username, clientIP)
+	counts := make([]*redis.IntCmd, 0, len(subjects))
+	_, err := g.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, s := range subjects {
+			counts = append(counts, pipe.Incr(ctx, s.key(loginFailedKeyPrefix)))
+			pipe.Expire(ctx, s.key(loginFailedKeyPrefix), g.conf.Window)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	locked := false
+	_, err = g.redis.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, s := range subjects {
+			n := counts[i].Val()
+			if s.threshold > 0 && n >= s.threshold {
+				locked = true
+				pipe.Set(ctx, s.key(loginLockedKeyPrefix), 1, g.conf.LockoutDuration)
+				pipe.Del(ctx, s.key(loginFailedKeyPrefix), s.key(loginBackoffKeyPrefix))
+				continue
+			}
+			if delay := g.backoff(n); delay > 0 {
+				pipe.Set(ctx, s.key(loginBackoffKeyPrefix), 1, delay)
+			}
+		}
+		return nil
+	})
+	return locked, err
+
+}
+
+// Succeed 登录成功后清空用户名的失败计数。客户端 IP 的计数保留到过期，
+// 避免攻击者用自己的账号登录来重置 IP 计数
+func (g *LoginGuard) Succeed(ctx context.Context, username string) error {
+
+	if !g.conf.Enabled {
+		return nil
+	}
+	s := g.subjects(username, "")[0]
+	return g.redis.Del(ctx, s.key(loginFailedKeyPrefix), s.key(loginBackoffKeyPrefix)).Err()
+
+}
+
+// backoff 第 n 次失败后的等待时间
+func (g *LoginGuard) backoff(n int64) time.Duration {
+
+	exceeded := n - g.conf.FreeAttempts
+	if exceeded <= 0 || g.conf.BackoffBase <= 0 {
+		return 0
+	}
+	delay := g.conf.BackoffBase
+	for i := int64(1); i < exceeded && i < 32; i++ {
+		delay *= 2
+		if g.conf.BackoffMax > 0 && delay >= g.conf.BackoffMax {
+			return g.conf.BackoffMax
+		}
+	}
+	if g.conf.BackoffMax > 0 && delay > g.conf.BackoffMax {
+		return g.conf.BackoffMax
+	}
+	return delay
+
+}
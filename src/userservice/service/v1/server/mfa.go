@@ -0,0 +1,341 @@
+package serverV1
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	"userservice/service/model"
+)
+
+const (
+	// mfaChallengeKeyPrefix 登录第二步 challenge key 前缀。完整 key 为 mfa_challenge:{sha256(token)}
+	mfaChallengeKeyPrefix = "mfa_challenge:"
+	// mfaCodeUsedKeyPrefix 已经使用的 TOTP 验证码。完整 key 为 mfa_code_used:{userId}:{code}
+	mfaCodeUsedKeyPrefix = "mfa_code_used:"
+)
+
+var (
+	// ErrMfaEnabled 已经开启二次验证
+	ErrMfaEnabled = errors.New("已经开启二次验证")
+	// ErrMfaNotEnrolled 没有生成二次验证密钥
+	ErrMfaNotEnrolled = errors.New("请先生成二次验证密钥")
+	// ErrInvalidMfaCode 二次验证码错误
+	ErrInvalidMfaCode = errors.New("二次验证码错误")
+	// ErrInvalidMfaToken mfa token 不存在或者已经过期
+	ErrInvalidMfaToken = errors.New("mfa token无效或者已过期，请重新登录")
+)
+
+// totpOpts TOTP 校验参数。允许前后各一个周期的时间误差
+var totpOpts = totp.ValidateOpts{
+	Period:    30,
+	Skew:      1,
+	Digits:    otp.DigitsSix,
+	Algorithm: otp.AlgorithmSHA1,
+}
+
+// mfaChallengeKey challenge key。只保存 token 的哈希值
+func mfaChallengeKey(mfaToken string) string {
+	sum := sha256.Sum256([]byte(mfaToken))
+	return mfaChallengeKeyPrefix + hex.EncodeToString(sum[:])
+}
+
+// hashRecoveryCode 恢复码只保存哈希值。忽略大小写以及分隔符
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// enabledMfa 获取用户已经开启的二次验证。没有开启时返回 nil
+func (r *Repository) enabledMfa(userID int64) (*model.UserMfa, error) {
+
+	mfa := &model.UserMfa{}
+	result := r.UserMfaModel().Where("user_id = ? AND is_enabled = ?", userID, model.MfaEnabled).Take(mfa)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return mfa, nil
+
+}
+
+// mfaChallenge 密码验证通过后生成登录第二步使用的 mfa token
+func (r *Repository) mfaChallenge(ctx context.Context, userID int64, account string) (*Token, error) {
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+	mfaToken := base64.RawURLEncoding.EncodeToString(buf)
+	key := mfaChallengeKey(mfaToken)
+	ttl := r.conf.Mfa.ChallengeTTL
+	_, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, "user_id", userID, "account", account, "attempts", 0)
+		pipe.Expire(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Token{
+		MfaToken:    mfaToken,
+		MfaExpireAt: time.Now().Add(ttl).Unix(),
+	}, nil
+
+}
+
+// VerifyMfa 登录第二步。验证 TOTP 验证码或者恢复码后签发 access token
+// 验证失败同样计入登录失败次数
+func (r *Repository) VerifyMfa(ctx context.Context, mfaToken string, code string) (*Token, error) {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	key := mfaChallengeKey(mfaToken)
+	challenge, err := r.redis.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	userID, err := strconv.ParseInt(challenge["user_id"], 10, 64)
+	if err != nil {
+		_ = r.span.Error(span, ErrInvalidMfaToken.Error())
+		return nil, ErrInvalidMfaToken
+	}
+	account := challenge["account"]
+
+	mfa, err := r.enabledMfa(userID)
+	if err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	// 管理员在登录过程中重置了二次验证
+	if mfa == nil {
+		r.redis.Del(ctx, key)
+		_ = r.span.Error(span, ErrInvalidMfaToken.Error())
+		return nil, ErrInvalidMfaToken
+	}
+
+	ok, err := r.checkMfaCode(ctx, mfa, code)
+	if err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	if !ok {
+		attempts, err := r.redis.HIncrBy(ctx, key, "attempts", 1).Result()
+		if err != nil {
+			return nil, r.span.Error(span, err.Error())
+		}
+		if max := r.conf.Mfa.MaxAttempts; max > 0 && attempts >= max {
+			r.redis.Del(ctx, key)
+		}
+		clientIP, _ := r.clientMetadata(ctx)
+		if err = r.loginFailed(ctx, span, account, clientIP); errors.Is(err, ErrInvalidCredentials) {
+			return nil, ErrInvalidMfaCode
+		}
+		return nil, err
+	}
+
+	// challenge 只能使用一次
+	n, err := r.redis.Del(ctx, key).Result()
+	if err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	if n == 0 {
+		_ = r.span.Error(span, ErrInvalidMfaToken.Error())
+		return nil, ErrInvalidMfaToken
+	}
+
+	user := &model.User{}
+	if result := r.UserModel().Where("id = ?", userID).First(user); result.Error != nil {
+		return nil, r.span.Error(span, result.Error.Error())
+	}
+	if user.IsDisable == model.UserDisabled {
+		_ = r.span.Error(span, ErrUserDisabled.Error())
+		return nil, ErrUserDisabled
+	}
+	if err = r.guard.Succeed(ctx, account); err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	issued, err := r.createSession(ctx, user)
+	if err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	return issued, nil
+
+}
+
+// checkMfaCode 校验 TOTP 验证码或者恢复码。同一个 TOTP 验证码只能使用一次，恢复码使用后删除
+func (r *Repository) checkMfaCode(ctx context.Context, mfa *model.UserMfa, code string) (bool, error) {
+
+	if len(code) == 6 && strings.Trim(code, "0123456789") == "" {
+		valid, err := totp.ValidateCustom(code, mfa.Secret, time.Now(), totpOpts)
+		if err != nil || !valid {
+			return false, nil
+		}
+		// 验证码在有效窗口内可以重复计算出来，需要防止重放
+		usedKey := mfaCodeUsedKeyPrefix + strconv.FormatInt(mfa.UserID, 10) + ":" + code
+		window := time.Duration(totpOpts.Period*(2*totpOpts.Skew+1)) * time.Second
+		return r.redis.SetNX(ctx, usedKey, 1, window).Result()
+	}
+
+	hash := hashRecoveryCode(code)
+	codes := strings.Split(mfa.RecoveryCodes, ",")
+	for i, v := range codes {
+		if v == "" || v != hash {
+			continue
+		}
+		remaining := strings.Join(append(codes[:i:i], codes[i+1:]...), ",")
+		// 条件更新，并发使用同一个恢复码时只有一个成功
+		result := r.UserMfaModel().
+			Where("id = ? AND recovery_codes = ?", mfa.ID, mfa.RecoveryCodes).
+			Updates(map[string]any{
+				"recovery_codes": remaining,
+				"update_time":    time.Now().Unix(),
+			})
+		if result.Error != nil {
+			return false, result.Error
+		}
+		return result.RowsAffected > 0, nil
+	}
+	return false, nil
+
+}
+
+// EnrollMfa 生成新的二次验证密钥。确认之前不会生效，重复调用时替换未确认的密钥
+// 返回密钥以及 otpauth URI
+func (r *Repository) EnrollMfa(ctx context.Context, userID int64) (string, string, error) {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	user := &model.User{}
+	if result := r.UserModel().Select("username").Where("id = ?", userID).First(user); result.Error != nil {
+		return "", "", r.span.Error(span, result.Error.Error())
+	}
+	mfa := &model.UserMfa{}
+	result := r.UserMfaModel().Where("user_id = ?", userID).Take(mfa)
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return "", "", r.span.Error(span, result.Error.Error())
+	}
+	if mfa.IsEnabled == model.MfaEnabled {
+		_ = r.span.Error(span, ErrMfaEnabled.Error())
+		return "", "", ErrMfaEnabled
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      r.conf.Mfa.Issuer,
+		AccountName: user.Username,
+		Period:      uint(totpOpts.Period),
+		Digits:      totpOpts.Digits,
+		Algorithm:   totpOpts.Algorithm,
+	})
+	if err != nil {
+		return "", "", r.span.Error(span, err.Error())
+	}
+
+	now := time.Now().Unix()
+	if mfa.ID == 0 {
+		result = r.UserMfaModel().Create(&model.UserMfa{
+			UserID:     userID,
+			Secret:     key.Secret(),
+			IsEnabled:  model.MfaPending,
+			CreateTime: now,
+			UpdateTime: now,
+		})
+	} else {
+		result = r.UserMfaModel().Where("id = ? AND is_enabled = ?", mfa.ID, model.MfaPending).Updates(map[string]any{
+			"secret":      key.Secret(),
+			"update_time": now,
+		})
+	}
+	if result.Error != nil {
+		return "", "", r.span.Error(span, result.Error.Error())
+	}
+	return key.Secret(), key.URL(), nil
+
+}
+
+// ConfirmMfa 使用验证码确认密钥并开启二次验证。返回只展示一次的恢复码
+func (r *Repository) ConfirmMfa(ctx context.Context, userID int64, code string) ([]string, error) {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	mfa := &model.UserMfa{}
+	result := r.UserMfaModel().Where("user_id = ?", userID).Take(mfa)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		_ = r.span.Error(span, ErrMfaNotEnrolled.Error())
+		return nil, ErrMfaNotEnrolled
+	}
+	if result.Error != nil {
+		return nil, r.span.Error(span, result.Error.Error())
+	}
+	if mfa.IsEnabled == model.MfaEnabled {
+		_ = r.span.Error(span, ErrMfaEnabled.Error())
+		return nil, ErrMfaEnabled
+	}
+	ok, err := r.checkMfaCode(ctx, mfa, code)
+	if err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	if !ok {
+		_ = r.span.Error(span, ErrInvalidMfaCode.Error())
+		return nil, ErrInvalidMfaCode
+	}
+
+	codes := make([]string, 0, r.conf.Mfa.RecoveryCodes)
+	hashes := make([]string, 0, r.conf.Mfa.RecoveryCodes)
+	encoding := base32.StdEncoding.WithPadding(base32.NoPadding)
+	for i := 0; i < r.conf.Mfa.RecoveryCodes; i++ {
+		buf := make([]byte, 5)
+		if _, err = rand.Read(buf); err != nil {
+			return nil, r.span.Error(span, err.Error())
+		}
+		// 8 位字符，按 4-4 分组方便抄写
+		v := strings.ToLower(encoding.EncodeToString(buf))
+		codes = append(codes, v[:4]+"-"+v[4:])
+		hashes = append(hashes, hashRecoveryCode(v))
+	}
+
+	result = r.UserMfaModel().Where("id = ? AND is_enabled = ?", mfa.ID, model.MfaPending).Updates(map[string]any{
+		"is_enabled":     model.MfaEnabled,
+		"recovery_codes": strings.Join(hashes, ","),
+		"update_time":    time.Now().Unix(),
+	})
+	if result.Error != nil {
+		return nil, r.span.Error(span, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		_ = r.span.Error(span, ErrMfaEnabled.Error())
+		return nil, ErrMfaEnabled
+	}
+	return codes, nil
+
+}
+
+// ResetMfa 管理员重置用户的二次验证。用户需要重新生成密钥
+func (r *Repository) ResetMfa(ctx context.Context, userID int64) error {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	if result := r.UserMfaModel().Where("user_id = ?", userID).Delete(&model.UserMfa{}); result.Error != nil {
+		return r.span.Error(span, result.Error.Error())
+	}
+	r.audit(ctx, span, AuditResetMfa, auditTargetUser, strconv.FormatInt(userID, 10), nil)
+	return nil
+
+}
@@ -1,19 +1,37 @@
 package serverV1
 
 import (
+	"authservice/pkg/identity"
+	"authservice/pkg/rbac"
+	"authservice/pkg/session"
+	"authservice/pkg/token"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/google/uuid"
-	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	Jgrpc_otelspan "github.com/janrs-io/Jgrpc-otel-span"
 	"github.com/redis/go-redis/v9"
-	"golang.org/x/crypto/bcrypt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"gorm.io/gorm"
+	"net"
 	orderPBV1 "orderservice/genproto/go/v1"
 	productPBV1 "productservice/genproto/go/v1"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 	"userservice/config"
 	userPBV1 "userservice/genproto/go/v1"
+	"userservice/pkg/notify"
+	"userservice/pkg/password"
 	"userservice/service/model"
 )
 
@@ -21,6 +39,13 @@ import (
 type Repository struct {
 	mysqlDB       *gorm.DB
 	redis         *redis.Client
+	sessions      *session.Store
+	rbac          *rbac.Store
+	guard         *LoginGuard
+	notifier      notify.Notifier
+	hasher        *password.Hasher
+	policy        *password.Policy
+	signer        *token.Signer
 	orderClient   orderPBV1.OrderServiceClient
 	productClient productPBV1.ProductServiceClient
 	userClient    userPBV1.UserServiceClient
@@ -32,6 +57,13 @@ type Repository struct {
 func NewRepository(
 	mysqlDB *gorm.DB,
 	redis *redis.Client,
+	sessions *session.Store,
+	rbac *rbac.Store,
+	guard *LoginGuard,
+	notifier notify.Notifier,
+	hasher *password.Hasher,
+	policy *password.Policy,
+	signer *token.Signer,
 	orderClient orderPBV1.OrderServiceClient,
 	productClient productPBV1.ProductServiceClient,
 	userClient userPBV1.UserServiceClient,
@@ -41,6 +73,13 @@ func NewRepository(
 	return &Repository{
 		mysqlDB:       mysqlDB,
 		redis:         redis,
+		sessions:      sessions,
+		rbac:          rbac,
+		guard:         guard,
+		notifier:      notifier,
+		hasher:        hasher,
+		policy:        policy,
+		signer:        signer,
 		orderClient:   orderClient,
 		productClient: productClient,
 		userClient:    userClient,
@@ -49,8 +88,13 @@ func NewRepository(
 	}
 }
 
-// UserModel User model
+// UserModel User model。不包含已经删除的用户
 func (r *Repository) UserModel() *gorm.DB {
+	return r.mysqlDB.Table("user").Where("delete_time = ?", 0)
+}
+
+// UnscopedUserModel User model。包含已经删除的用户，用于检查用户名是否被占用
+func (r *Repository) UnscopedUserModel() *gorm.DB {
 	return r.mysqlDB.Table("user")
 }
 
@@ -60,7 +104,7 @@ func (r *Repository) IsUsernameExists(ctx context.Context, username string) (boo
 	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
 	defer span.End()
 
-	result := r.UserModel().Where("username = ?", username).Find(&model.User{}).Limit(1)
+	result := r.UnscopedUserModel().Where("username = ?", username).Find(&model.User{}).Limit(1)
 
 	if result.Error != nil {
 		return false, r.span.Error(span, result.Error.Error())
@@ -78,17 +122,25 @@ func (r *Repository) Register(ctx context.Context, request *userPBV1.RegisterReq
 	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
 	defer span.End()
 
-	password, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+	if isContactLike(request.Username) {
+		_ = r.span.Error(span, ErrInvalidUsername.Error())
+		return false, ErrInvalidUsername
+	}
+	if err := r.policy.Validate(request.Password, request.Username); err != nil {
+		_ = r.span.Error(span, err.Error())
+		return false, err
+	}
+	hash, err := r.hasher.Hash(request.Password)
 	if err != nil {
 		return false, r.span.Error(span, err.Error())
 	}
 	user := &model.User{}
 	user.Username = request.Username
-	user.Password = string(password)
+	user.Password = hash
 	user.CreateTime = time.Now().Unix()
 	user.UpdateTime = time.Now().Unix()
 
-	result := r.UserModel().Create(&user)
+	result := r.UnscopedUserModel().Create(&user)
 	if result.Error != nil {
 		return false, r.span.Error(span, result.Error.Error())
 	}
@@ -96,75 +148,406 @@ func (r *Repository) Register(ctx context.Context, request *userPBV1.RegisterReq
 
 }
 
+var (
+	// ErrUsernameExists 用户名已经被其他用户使用
+	ErrUsernameExists = errors.New("用户名已存在")
+	// ErrInvalidUsername 用户名是手机号或者邮箱格式，登录时无法与手机号以及邮箱区分
+	ErrInvalidUsername = errors.New("用户名不能是手机号或者邮箱")
+	// ErrInvalidUpdateMask updateMask 为空或者包含不支持更新的字段
+	ErrInvalidUpdateMask = errors.New("更新字段不合法")
+	// ErrWrongPassword 当前密码错误
+	ErrWrongPassword = errors.New("当前密码错误")
+)
+
+// Token 签发给客户端的令牌数据
+type Token struct {
+	Username        string
+	AccessToken     string
+	AccessExpireAt  int64
+	RefreshToken    string
+	RefreshExpireAt int64
+	// 开启二次验证时只返回 mfa token
+	MfaToken    string
+	MfaExpireAt int64
+}
+
+// refreshTokenData refresh token 在 redis 中保存的数据
+type refreshTokenData struct {
+	SessionID   string `json:"session_id"`
+	UserID      int64  `json:"user_id"`
+	AccessToken string `json:"access_token"`
+}
+
+// refreshTokenKey refresh token 数据 key。只保存 token 的哈希值
+func refreshTokenKey(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return "refresh_token:" + hex.EncodeToString(sum[:])
+}
+
+// refreshTokenUsedKey refresh token 已使用标记 key
+func refreshTokenUsedKey(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return "refresh_token_used:" + hex.EncodeToString(sum[:])
+}
+
 // Login 用户登录
-func (r *Repository) Login(ctx context.Context, request *userPBV1.LoginRequest) (*model.User, error) {
+func (r *Repository) Login(ctx context.Context, request *userPBV1.LoginRequest) (*Token, error) {
 
-	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
 	defer span.End()
 
+	// 退避等待或者锁定期间直接拒绝，不再验证密码
+	clientIP, _ := r.clientMetadata(ctx)
+	wait, err := r.guard.Check(ctx, request.Username, clientIP)
+	if err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	if wait > 0 {
+		span.SetAttributes(attribute.Int64("login.retry_after_ms", wait.Milliseconds()))
+		_ = r.span.Error(span, ErrTooManyAttempts.Error())
+		return nil, ErrTooManyAttempts
+	}
+
+	// 查询用户是否存在
 	user := &model.User{}
+	result := r.findUserByAccount(request.Username, user)
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, r.span.Error(span, result.Error.Error())
+	}
 
-	// 查询用户名是否存在
-	result := r.UserModel().Where("username = ?", request.Username).First(&user)
-	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-		return nil, r.span.Error(span, "账号或密码不存在")
+	// 验证密码。用户不存在时同样比较一次哈希，避免通过响应时间判断用户名是否存在
+	ok, err := r.hasher.Verify(user.Password, request.Password)
+	if err != nil || !ok || result.Error != nil {
+		return nil, r.loginFailed(ctx, span, request.Username, clientIP)
 	}
+	r.rehashPassword(ctx, span, user, request.Password)
 
-	// 验证密码
-	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(request.Password)); err != nil {
-		return nil, r.span.Error(span, "账号或密码错误")
+	// 密码正确后才返回禁用状态，不会泄露账号是否存在
+	if user.IsDisable == model.UserDisabled {
+		_ = r.span.Error(span, ErrUserDisabled.Error())
+		return nil, ErrUserDisabled
 	}
 
-	// 创建 accessToken 以及设置 token 过期时间
-	accessToken := uuid.New().String()
+	// 开启二次验证时只返回 mfa token，验证通过后再签发 access token
+	mfa, err := r.enabledMfa(user.ID)
+	if err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	if mfa != nil {
+		issued, err := r.mfaChallenge(ctx, user.ID, request.Username)
+		if err != nil {
+			return nil, r.span.Error(span, err.Error())
+		}
+		return issued, nil
+	}
 
-	// 创建新的用户登录授权信息
-	var duration int64 = 7 * 24 * 60 * 60
-	user.AccessToken = accessToken
-	user.AccessTokenExpireTime = time.Now().Unix() + duration
-	user.UpdateTime = time.Now().Unix()
+	if err = r.guard.Succeed(ctx, request.Username); err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	issued, err := r.createSession(ctx, user)
+	if err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	return issued, nil
+
+}
+
+// rehashPassword 密码哈希的算法或者参数已经过期时使用当前配置重新生成。失败时不影响登录
+func (r *Repository) rehashPassword(ctx context.Context, span trace.Span, user *model.User, plain string) {
+
+	if !r.hasher.NeedsRehash(user.Password) {
+		return
+	}
+	hash, err := r.hasher.Hash(plain)
+	if err != nil {
+		_ = r.span.Error(span, "更新密码哈希失败："+err.Error())
+		return
+	}
+	// 条件更新，避免覆盖并发修改的密码
+	result := r.UserModel().WithContext(ctx).
+		Where("id = ? AND password = ?", user.ID, user.Password).
+		Update("password", hash)
+	if result.Error != nil {
+		_ = r.span.Error(span, "更新密码哈希失败："+result.Error.Error())
+		return
+	}
+	span.AddEvent("login.password_rehashed")
+
+}
+
+// createSession 创建新的登录会话并签发 token
+func (r *Repository) createSession(ctx context.Context, user *model.User) (*Token, error) {
+
+	clientIP, userAgent := r.clientMetadata(ctx)
+	sess := &session.Session{
+		ID:        uuid.NewString(),
+		UserID:    user.ID,
+		Username:  user.Username,
+		ClientIP:  clientIP,
+		UserAgent: userAgent,
+	}
+	issued, err := r.issueToken(ctx, nil, sess)
+	if err != nil {
+		return nil, err
+	}
 
 	// 保存新的登录数据到数据库
-	result = r.UserModel().Save(&user)
+	result := r.UserModel().Where("id = ?", user.ID).Updates(map[string]any{
+		"access_token_expire_time": issued.AccessExpireAt,
+		"update_time":              time.Now().Unix(),
+	})
 	if result.Error != nil {
 		return nil, result.Error
 	}
+	return issued, nil
 
-	return user, nil
+}
 
+// phonePattern 手机号格式，与 UpdateRequest.phone 的校验规则一致
+var phonePattern = regexp.MustCompile(`^\+?[0-9]{6,19}$`)
+
+// isContactLike 账号是否为邮箱或者手机号格式。用户名不能是这两种格式，登录时按照格式区分账号类型
+func isContactLike(account string) bool {
+	return strings.Contains(account, "@") || phonePattern.MatchString(account)
 }
 
-// Logout 用户退出登录
-// 用户退出登录后调用 auth 服务删除授权数据并且删除数据库 access token
-func (r *Repository) Logout(ctx context.Context, accessToken string) (bool, error) {
+// findUserByAccount 根据账号查询用户。账号可以是用户名或者已经验证的邮箱以及手机号，按照账号格式确定查询的字段
+func (r *Repository) findUserByAccount(account string, user *model.User) *gorm.DB {
+	switch {
+	case strings.Contains(account, "@"):
+		return r.UserModel().Where("email = ? AND email_verified = ?", account, model.Verified).Take(user)
+	case phonePattern.MatchString(account):
+		return r.UserModel().Where("phone = ? AND phone_verified = ?", account, model.Verified).Take(user)
+	}
+	return r.UserModel().Where("username = ?", account).Take(user)
+}
 
-	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+// loginFailed 记录登录失败。触发锁定时在 span 中记录锁定事件
+func (r *Repository) loginFailed(ctx context.Context, span trace.Span, username string, clientIP string) error {
+
+	locked, err := r.guard.Fail(ctx, username, clientIP)
+	if err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	if locked {
+		span.AddEvent("login.lockout", trace.WithAttributes(
+			attribute.String("login.username", username),
+			attribute.String("login.client_ip", clientIP),
+		))
+		_ = r.span.Error(span, ErrAccountLocked.Error())
+		return ErrAccountLocked
+	}
+	_ = r.span.Error(span, ErrInvalidCredentials.Error())
+	return ErrInvalidCredentials
+
+}
+
+// Refresh 使用 refresh token 换取新的 access token
+// 每个 refresh token 只能使用一次。已使用的 token 保留到过期，
+// 再次出现说明 token 已经泄露，注销整个会话
+func (r *Repository) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
 	defer span.End()
 
-	// 删除数据库 access token
+	data, err := r.redis.Get(ctx, refreshTokenKey(refreshToken)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, r.span.Error(span, "refresh token不存在")
+	}
+	if err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	stored := &refreshTokenData{}
+	if err = json.Unmarshal(data, stored); err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+
+	// 标记已使用。标记失败说明 token 被重复使用
+	ok, err := r.redis.SetNX(ctx, refreshTokenUsedKey(refreshToken), 1, r.conf.Token.RefreshTokenTTL).Result()
+	if err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	if !ok {
+		if err = r.sessions.DeleteByID(ctx, stored.UserID, stored.SessionID); err != nil {
+			return nil, r.span.Error(span, err.Error())
+		}
+		return nil, r.span.Error(span, "refresh token被重复使用，已注销会话")
+	}
+
+	// 会话已经退出或者被注销
+	old, err := r.sessions.Get(ctx, stored.AccessToken)
+	if err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	if old.ID != stored.SessionID {
+		return nil, r.span.Error(span, "refresh token与会话不匹配")
+	}
+	if old.AbsoluteExpireAt > 0 && time.Now().Unix() >= old.AbsoluteExpireAt {
+		return nil, r.span.Error(span, "会话已超过最长有效期，请重新登录")
+	}
+
+	// 禁用的账号不能继续刷新 token
 	user := &model.User{}
-	result := r.UserModel().Where("access_token = ?", accessToken).First(&user)
-	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-		return false, r.span.Error(span, result.Error.Error())
+	if err = r.UserModel().Select("username", "is_disable").Where("id = ?", old.UserID).First(user).Error; err != nil {
+		return nil, r.span.Error(span, err.Error())
 	}
-	user.AccessToken = ""
-	user.AccessTokenExpireTime = 0
-	result = r.UserModel().Save(&user)
-	if result.Error != nil {
-		return false, r.span.Error(span, result.Error.Error())
+	if user.IsDisable == model.UserDisabled {
+		return nil, r.span.Error(span, ErrUserDisabled.Error())
+	}
+
+	sess := *old
+	sess.Username = user.Username
+	sess.ClientIP, sess.UserAgent = r.clientMetadata(ctx)
+	issued, err := r.issueToken(ctx, old, &sess)
+	if err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	return issued, nil
+
+}
+
+// issueToken 签发 access token 以及 refresh token 并保存会话数据
+// old 不为空时替换旧的会话数据
+func (r *Repository) issueToken(ctx context.Context, old *session.Session, sess *session.Session) (*Token, error) {
+
+	now := time.Now()
+	if old == nil {
+		sess.CreatedAt = now.Unix()
+		if r.conf.Session.MaxLifetime > 0 {
+			sess.AbsoluteExpireAt = now.Add(r.conf.Session.MaxLifetime).Unix()
+		}
+		sess.IdleTimeout = int64(r.conf.Session.IdleTimeout / time.Second)
+	}
+	sess.LastActiveAt = now.Unix()
+
+	// access token 以及 refresh token 都不能超过会话的绝对过期时间
+	accessExpireAt := now.Add(r.conf.Token.AccessTokenTTL)
+	refreshExpireAt := now.Add(r.conf.Token.RefreshTokenTTL)
+	if sess.AbsoluteExpireAt > 0 {
+		absoluteExpireAt := time.Unix(sess.AbsoluteExpireAt, 0)
+		if !now.Before(absoluteExpireAt) {
+			return nil, session.ErrExpired
+		}
+		if accessExpireAt.After(absoluteExpireAt) {
+			accessExpireAt = absoluteExpireAt
+		}
+		if refreshExpireAt.After(absoluteExpireAt) {
+			refreshExpireAt = absoluteExpireAt
+		}
+	}
+
+	roles, err := r.rbac.UserRoles(ctx, sess.UserID)
+	if err != nil {
+		return nil, err
+	}
+	accessToken, err := r.signer.Sign(&token.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    r.conf.Token.Issuer,
+			Subject:   strconv.FormatInt(sess.UserID, 10),
+			Audience:  r.conf.Token.Audience,
+			ExpiresAt: jwt.NewNumericDate(accessExpireAt),
+			NotBefore: jwt.NewNumericDate(now),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+		SessionID: sess.ID,
+		Username:  sess.Username,
+		Roles:     roles,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 32)
+	if _, err = rand.Read(buf); err != nil {
+		return nil, err
+	}
+	refreshToken := base64.RawURLEncoding.EncodeToString(buf)
+
+	sess.Token = accessToken
+	sess.IssuedAt = now.Unix()
+	sess.ExpireAt = accessExpireAt.Unix()
+	if old == nil {
+		err = r.sessions.Save(ctx, sess)
+	} else {
+		err = r.sessions.Rotate(ctx, old, sess)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(&refreshTokenData{
+		SessionID:   sess.ID,
+		UserID:      sess.UserID,
+		AccessToken: accessToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = r.redis.Set(ctx, refreshTokenKey(refreshToken), data, refreshExpireAt.Sub(now)).Err(); err != nil {
+		return nil, err
+	}
+
+	return &Token{
+		Username:        sess.Username,
+		AccessToken:     accessToken,
+		AccessExpireAt:  accessExpireAt.Unix(),
+		RefreshToken:    refreshToken,
+		RefreshExpireAt: refreshExpireAt.Unix(),
+	}, nil
+
+}
+
+// Logout 用户退出登录
+// 删除会话数据后 authservice 鉴权失败，对应的 refresh token 也无法继续使用
+func (r *Repository) Logout(ctx context.Context, principal *identity.Principal) (bool, error) {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	if err := r.sessions.DeleteByID(ctx, principal.UserID, principal.SessionID); err != nil {
+		return false, r.span.Error(span, err.Error())
 	}
 	return true, nil
 
 }
 
+// ListSessions 获取用户的所有登录会话
+func (r *Repository) ListSessions(ctx context.Context, userID int64) ([]*session.Session, error) {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	sessions, err := r.sessions.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	return sessions, nil
+
+}
+
+// RevokeSessions 注销用户的所有登录会话
+func (r *Repository) RevokeSessions(ctx context.Context, userID int64) (int64, error) {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	revoked, err := r.sessions.RevokeUser(ctx, userID)
+	if err != nil {
+		return 0, r.span.Error(span, err.Error())
+	}
+	return revoked, nil
+
+}
+
 // Info 获取用户信息
-func (r *Repository) Info(ctx context.Context, accessToken string) (*model.User, error) {
+func (r *Repository) Info(ctx context.Context, userID int64) (*model.User, error) {
 
 	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
 	defer span.End()
 
 	user := &model.User{}
-	result := r.UserModel().Where("access_token = ?", accessToken).First(&user)
+	result := r.UserModel().Where("id = ?", userID).First(&user)
 	if result.Error != nil {
 		return nil, r.span.Error(span, result.Error.Error())
 	}
@@ -172,60 +555,455 @@ func (r *Repository) Info(ctx context.Context, accessToken string) (*model.User,
 
 }
 
-// UserInfo 获取用户详情
-func (r *Repository) UserInfo(ctx context.Context) (*userPBV1.UserDetail_Detail, error) {
+// Update 更新 updateMask 中列出的用户字段。修改用户名时检查是否与其他用户重复
+// 已经签发的 access token 中的用户名在下次刷新时更新
+func (r *Repository) Update(ctx context.Context, userID int64, request *userPBV1.UpdateRequest) (*model.User, error) {
 
 	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
 	defer span.End()
 
+	values := map[string]any{
+		"username":  request.Username,
+		"nick_name": request.NickName,
+		"real_name": request.RealName,
+		"sex":       request.Sex,
+		"email":     request.Email,
+		"phone":     request.Phone,
+	}
+	updates := map[string]any{}
+	for _, path := range request.GetUpdateMask().GetPaths() {
+		v, ok := values[path]
+		if !ok {
+			_ = r.span.Error(span, "不支持更新的字段："+path)
+			return nil, ErrInvalidUpdateMask
+		}
+		updates[path] = v
+	}
+	if len(updates) == 0 {
+		_ = r.span.Error(span, ErrInvalidUpdateMask.Error())
+		return nil, ErrInvalidUpdateMask
+	}
 	user := &model.User{}
+	if result := r.UserModel().Where("id = ?", userID).First(user); result.Error != nil {
+		return nil, r.span.Error(span, result.Error.Error())
+	}
+	// 修改邮箱或者手机号后需要重新验证
+	if email, ok := updates["email"]; ok && email != user.Email {
+		updates["email_verified"] = model.Unverified
+	}
+	if phone, ok := updates["phone"]; ok && phone != user.Phone {
+		updates["phone_verified"] = model.Unverified
+	}
+	if username, ok := updates["username"]; ok {
+		if username == "" {
+			_ = r.span.Error(span, ErrInvalidUpdateMask.Error())
+			return nil, ErrInvalidUpdateMask
+		}
+		if isContactLike(username.(string)) {
+			_ = r.span.Error(span, ErrInvalidUsername.Error())
+			return nil, ErrInvalidUsername
+		}
+		var count int64
+		result := r.UnscopedUserModel().Where("username = ? AND id <> ?", username, userID).Count(&count)
+		if result.Error != nil {
+			return nil, r.span.Error(span, result.Error.Error())
+		}
+		if count > 0 {
+			_ = r.span.Error(span, ErrUsernameExists.Error())
+			return nil, ErrUsernameExists
+		}
+	}
+	updates["update_time"] = time.Now().Unix()
 
-	accessToken, err := auth.AuthFromMD(ctx, "Bearer")
+	result := r.UserModel().Where("id = ?", userID).Updates(updates)
+	if result.Error != nil {
+		return nil, r.span.Error(span, result.Error.Error())
+	}
+	user = &model.User{}
+	if result = r.UserModel().Where("id = ?", userID).First(user); result.Error != nil {
+		return nil, r.span.Error(span, result.Error.Error())
+	}
+	return user, nil
+
+}
+
+// ChangePassword 验证当前密码后修改密码，并注销用户所有登录会话
+func (r *Repository) ChangePassword(ctx context.Context, userID int64, request *userPBV1.ChangePasswordRequest) (int64, error) {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	user := &model.User{}
+	if result := r.UserModel().Select("username", "password").Where("id = ?", userID).First(user); result.Error != nil {
+		return 0, r.span.Error(span, result.Error.Error())
+	}
+	ok, err := r.hasher.Verify(user.Password, request.OldPassword)
 	if err != nil {
-		return nil, r.span.Error(span, err.Error())
+		return 0, r.span.Error(span, err.Error())
+	}
+	if !ok {
+		_ = r.span.Error(span, ErrWrongPassword.Error())
+		return 0, ErrWrongPassword
+	}
+	if err = r.policy.Validate(request.NewPassword, user.Username); err != nil {
+		_ = r.span.Error(span, err.Error())
+		return 0, err
 	}
 
-	result := r.UserModel().Where("access_token = ?", accessToken).First(&user)
+	hash, err := r.hasher.Hash(request.NewPassword)
+	if err != nil {
+		return 0, r.span.Error(span, err.Error())
+	}
+	result := r.UserModel().Where("id = ?", userID).Updates(map[string]any{
+		"password":    hash,
+		"update_time": time.Now().Unix(),
+	})
 	if result.Error != nil {
+		return 0, r.span.Error(span, result.Error.Error())
+	}
+
+	revoked, err := r.sessions.RevokeUser(ctx, userID)
+	if err != nil {
+		return 0, r.span.Error(span, err.Error())
+	}
+	return revoked, nil
+
+}
+
+// RoleModel Role model
+func (r *Repository) RoleModel() *gorm.DB {
+	return r.mysqlDB.Table("role")
+}
+
+// UserRoleModel UserRole model
+func (r *Repository) UserRoleModel() *gorm.DB {
+	return r.mysqlDB.Table("user_role")
+}
+
+// UserMfaModel UserMfa model
+func (r *Repository) UserMfaModel() *gorm.DB {
+	return r.mysqlDB.Table("user_mfa")
+}
+
+// ListRoles 获取角色列表
+func (r *Repository) ListRoles(ctx context.Context) ([]*model.Role, error) {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	var roles []*model.Role
+	if result := r.RoleModel().Order("id ASC").Find(&roles); result.Error != nil {
 		return nil, r.span.Error(span, result.Error.Error())
 	}
+	return roles, nil
 
-	return &userPBV1.UserDetail_Detail{
-		Id:                    user.ID,
-		Username:              user.Username,
-		Sex:                   user.Sex,
-		IdNumber:              user.IDNumber,
-		Email:                 user.Email,
-		Phone:                 user.Phone,
-		IsDisable:             user.IsDisable,
-		AccessToken:           user.AccessToken,
-		AccessTokenExpireTime: user.AccessTokenExpireTime,
-		NickName:              user.NickName,
-		RealName:              user.RealName,
-		CreateTime:            user.CreateTime,
-		UpdateTime:            user.UpdateTime,
-	}, nil
+}
+
+// SaveRole 添加或者更新角色，并同步到 redis
+func (r *Repository) SaveRole(ctx context.Context, request *userPBV1.SaveRoleRequest) (*model.Role, error) {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	if request.Name == rbac.RoleAdmin {
+		return nil, r.span.Error(span, "内置角色不能修改")
+	}
+
+	now := time.Now().Unix()
+	role := &model.Role{}
+	result := r.RoleModel().Where("name = ?", request.Name).Limit(1).Find(role)
+	if result.Error != nil {
+		return nil, r.span.Error(span, result.Error.Error())
+	}
+	role.Name = request.Name
+	role.Description = request.Description
+	role.Permissions = strings.Join(request.Permissions, ",")
+	role.UpdateTime = now
+	if result.RowsAffected == 0 {
+		role.CreateTime = now
+		result = r.RoleModel().Create(role)
+	} else {
+		result = r.RoleModel().Where("id = ?", role.ID).Updates(map[string]any{
+			"description": role.Description,
+			"permissions": role.Permissions,
+			"update_time": role.UpdateTime,
+		})
+	}
+	if result.Error != nil {
+		return nil, r.span.Error(span, result.Error.Error())
+	}
+
+	if err := r.rbac.SetRolePermissions(ctx, role.Name, request.Permissions); err != nil {
+		return nil, r.span.Error(span, err.Error())
+	}
+	r.audit(ctx, span, AuditSaveRole, auditTargetRole, role.Name, request)
+	return role, nil
+
+}
+
+// DeleteRole 删除角色以及用户的角色关联，并同步到 redis
+func (r *Repository) DeleteRole(ctx context.Context, name string) error {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	if name == rbac.RoleAdmin {
+		return r.span.Error(span, "内置角色不能删除")
+	}
+	role := &model.Role{}
+	if result := r.RoleModel().Where("name = ?", name).First(role); result.Error != nil {
+		return r.span.Error(span, result.Error.Error())
+	}
+
+	var userIDs []int64
+	if result := r.UserRoleModel().Where("role_id = ?", role.ID).Pluck("user_id", &userIDs); result.Error != nil {
+		return r.span.Error(span, result.Error.Error())
+	}
+	err := r.mysqlDB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("user_role").Where("role_id = ?", role.ID).Delete(&model.UserRole{}).Error; err != nil {
+			return err
+		}
+		return tx.Table("role").Where("id = ?", role.ID).Delete(&model.Role{}).Error
+	})
+	if err != nil {
+		return r.span.Error(span, err.Error())
+	}
+
+	if err = r.rbac.DeleteRole(ctx, name); err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	for _, userID := range userIDs {
+		if err = r.syncUserRoles(ctx, userID); err != nil {
+			return r.span.Error(span, err.Error())
+		}
+	}
+	r.audit(ctx, span, AuditDeleteRole, auditTargetRole, name, nil)
+	return nil
+
+}
+
+// AssignRoles 覆盖用户的角色，并同步到 redis
+func (r *Repository) AssignRoles(ctx context.Context, userID int64, names []string) error {
+
+	_, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	if result := r.UserModel().Where("id = ?", userID).First(&model.User{}); result.Error != nil {
+		return r.span.Error(span, result.Error.Error())
+	}
+	var roles []*model.Role
+	if len(names) > 0 {
+		if result := r.RoleModel().Where("name IN ?", names).Find(&roles); result.Error != nil {
+			return r.span.Error(span, result.Error.Error())
+		}
+		if len(roles) != len(names) {
+			return r.span.Error(span, "角色不存在")
+		}
+	}
+
+	now := time.Now().Unix()
+	err := r.mysqlDB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("user_role").Where("user_id = ?", userID).Delete(&model.UserRole{}).Error; err != nil {
+			return err
+		}
+		for _, role := range roles {
+			userRole := &model.UserRole{UserID: userID, RoleID: role.ID, CreateTime: now}
+			if err := tx.Table("user_role").Create(userRole).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return r.span.Error(span, err.Error())
+	}
+
+	if err = r.rbac.SetUserRoles(ctx, userID, names); err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	r.audit(ctx, span, AuditAssignRoles, auditTargetUser, strconv.FormatInt(userID, 10), map[string]any{"roles": names})
+	return nil
+
+}
+
+// SyncRbac 初始化内置角色以及管理员，并将 mysql 中的角色权限全量同步到 redis
+func (r *Repository) SyncRbac(ctx context.Context) error {
+
+	now := time.Now().Unix()
+
+	// 内置管理员角色
+	admin := &model.Role{}
+	result := r.RoleModel().Where(&model.Role{Name: rbac.RoleAdmin}).Attrs(&model.Role{
+		Description: "内置管理员",
+		Permissions: rbac.PermissionAll,
+		CreateTime:  now,
+		UpdateTime:  now,
+	}).FirstOrCreate(admin)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	// 初始化管理员用户
+	for _, username := range r.conf.Rbac.BootstrapAdmins {
+		user := &model.User{}
+		result = r.UserModel().Where("username = ?", username).Limit(1).Find(user)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+		result = r.UserRoleModel().Where(&model.UserRole{UserID: user.ID, RoleID: admin.ID}).
+			Attrs(&model.UserRole{CreateTime: now}).
+			FirstOrCreate(&model.UserRole{})
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+
+	// 同步角色权限
+	var roles []*model.Role
+	if result = r.RoleModel().Find(&roles); result.Error != nil {
+		return result.Error
+	}
+	roleNames := make(map[int64]string, len(roles))
+	for _, role := range roles {
+		roleNames[role.ID] = role.Name
+		if err := r.rbac.SetRolePermissions(ctx, role.Name, splitPermissions(role.Permissions)); err != nil {
+			return err
+		}
+	}
+
+	// 同步用户角色
+	var userRoles []*model.UserRole
+	if result = r.UserRoleModel().Find(&userRoles); result.Error != nil {
+		return result.Error
+	}
+	grouped := make(map[int64][]string)
+	for _, v := range userRoles {
+		if name, ok := roleNames[v.RoleID]; ok {
+			grouped[v.UserID] = append(grouped[v.UserID], name)
+		}
+	}
+	for userID, names := range grouped {
+		if err := r.rbac.SetUserRoles(ctx, userID, names); err != nil {
+			return err
+		}
+	}
+	return nil
+
+}
+
+// syncUserRoles 将用户在 mysql 中的角色同步到 redis
+func (r *Repository) syncUserRoles(ctx context.Context, userID int64) error {
+
+	var names []string
+	result := r.UserRoleModel().
+		Joins("JOIN role ON role.id = user_role.role_id").
+		Where("user_role.user_id = ?", userID).
+		Pluck("role.name", &names)
+	if result.Error != nil {
+		return result.Error
+	}
+	return r.rbac.SetUserRoles(ctx, userID, names)
+
+}
+
+// splitPermissions 解析逗号分隔的权限
+func splitPermissions(permissions string) []string {
+
+	var result []string
+	for _, v := range strings.Split(permissions, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
 
 }
 
 // ProductInfo 获取产品详情
-func (r *Repository) ProductInfo(ctx context.Context, request *userPBV1.OrderInfoRequest) (*productPBV1.Response, error) {
+func (r *Repository) ProductInfo(ctx context.Context, productID int64) (*productPBV1.Response, error) {
 
 	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
 	defer span.End()
 
 	// 获取产品信息
-	return r.productClient.Detail(ctx, &productPBV1.DetailRequest{Id: request.ProductId})
+	return r.productClient.Detail(ctx, &productPBV1.DetailRequest{Id: productID})
 
 }
 
-// OrderInfo 获取订单详情
-func (r *Repository) OrderInfo(ctx context.Context, request *userPBV1.OrderInfoRequest) (*orderPBV1.Response, error) {
+// OrderInfo 获取订单详情。orderservice 根据传递的调用方身份检查订单归属，
+// 非管理员获取其他用户的订单时返回 NotFound
+func (r *Repository) OrderInfo(ctx context.Context, request *userPBV1.OrderInfoRequest) (*orderPBV1.Response, *orderPBV1.OrderDetail, error) {
 
 	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
 	defer span.End()
 
 	// 获取订单信息
-	return r.orderClient.Detail(ctx, &orderPBV1.DetailRequest{Id: request.OrderId})
+	resp, err := r.orderClient.Detail(ctx, &orderPBV1.DetailRequest{Id: request.OrderId})
+	if err != nil {
+		_ = r.span.Error(span, err.Error())
+		return nil, nil, err
+	}
+	order := &orderPBV1.OrderDetail{}
+	if err = resp.ProtoAnyData.UnmarshalTo(order); err != nil {
+		return nil, nil, r.span.Error(span, err.Error())
+	}
+	return resp, order, nil
+
+}
+
+// clientMetadata 获取客户端 IP 以及 User-Agent
+// 请求经过 grpc-gateway 时使用 x-forwarded-for 中跳过可信代理后最右边的地址。
+// grpc-gateway 会把连接到网关的地址追加到末尾，更左边的地址可以由客户端伪造
+func (r *Repository) clientMetadata(ctx context.Context) (clientIP string, userAgent string) {
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get("x-forwarded-for"); len(v) > 0 {
+			clientIP = forwardedClientIP(v, r.conf.Proxy.TrustedProxies)
+		}
+		if v := md.Get("grpcgateway-user-agent"); len(v) > 0 {
+			userAgent = v[0]
+		} else if v = md.Get("user-agent"); len(v) > 0 {
+			userAgent = v[0]
+		}
+	}
+	if clientIP == "" {
+		// 只保留主机部分。每个连接的源端口不同，带端口时按 IP 统计的登录失败次数不会累加
+		if p, ok := peer.FromContext(ctx); ok {
+			clientIP = p.Addr.String()
+			if host, _, err := net.SplitHostPort(clientIP); err == nil {
+				clientIP = host
+			}
+		}
+	}
+	return clientIP, userAgent
+
+}
+
+// forwardedClientIP 从 x-forwarded-for 中获取客户端 IP。从右往左跳过 trustedProxies 个可信代理追加的地址，
+// 地址数量不足时使用最左边的地址
+func forwardedClientIP(values []string, trustedProxies int) string {
+
+	var hops []string
+	for _, value := range values {
+		for _, hop := range strings.Split(value, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	if len(hops) == 0 {
+		return ""
+	}
+	if trustedProxies < 0 {
+		trustedProxies = 0
+	}
+	index := len(hops) - 1 - trustedProxies
+	if index < 0 {
+		index = 0
+	}
+	return hops[index]
 
 }
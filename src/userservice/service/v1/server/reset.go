@@ -0,0 +1,164 @@
+package serverV1
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	"userservice/pkg/notify"
+	"userservice/service/model"
+)
+
+const (
+	// passwordResetKeyPrefix 重置 token key 前缀。完整 key 为 password_reset:{sha256(token)}
+	passwordResetKeyPrefix = "password_reset:"
+	// passwordResetUserKeyPrefix 用户最新的重置 token 哈希。重新发送时让旧 token 失效
+	passwordResetUserKeyPrefix = "password_reset_user:"
+	// passwordResetSentKeyPrefix 发送间隔 key 前缀
+	passwordResetSentKeyPrefix = "password_reset_sent:"
+)
+
+// ErrInvalidResetToken 重置 token 不存在、已经使用或者已经过期
+var ErrInvalidResetToken = errors.New("重置链接无效或者已过期")
+
+// passwordResetKey 重置 token key。只保存 token 的哈希值
+func passwordResetKey(resetToken string) string {
+	sum := sha256.Sum256([]byte(resetToken))
+	return passwordResetKeyPrefix + hex.EncodeToString(sum[:])
+}
+
+// RequestPasswordReset 生成重置 token 并发送到用户已经验证的邮箱或者手机号
+// 账号不存在、没有验证过的联系方式或者发送过于频繁时同样返回成功，不泄露账号是否存在
+func (r *Repository) RequestPasswordReset(ctx context.Context, account string) error {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	user := &model.User{}
+	result := r.findUserByAccount(account, user)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if result.Error != nil {
+		return r.span.Error(span, result.Error.Error())
+	}
+
+	msg := &notify.Message{Subject: "找回密码"}
+	switch {
+	case user.EmailVerified == model.Verified:
+		msg.Channel, msg.To = notify.ChannelEmail, user.Email
+	case user.PhoneVerified == model.Verified:
+		msg.Channel, msg.To = notify.ChannelPhone, user.Phone
+	default:
+		return nil
+	}
+
+	conf := r.conf.PasswordReset
+	userID := strconv.FormatInt(user.ID, 10)
+	ok, err := r.redis.SetNX(ctx, passwordResetSentKeyPrefix+userID, 1, conf.ResendInterval).Result()
+	if err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	if !ok {
+		return nil
+	}
+
+	buf := make([]byte, 32)
+	if _, err = rand.Read(buf); err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	resetToken := base64.RawURLEncoding.EncodeToString(buf)
+	key := passwordResetKey(resetToken)
+
+	// 同一用户只保留最新的重置 token
+	previous, err := r.redis.Get(ctx, passwordResetUserKeyPrefix+userID).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return r.span.Error(span, err.Error())
+	}
+	_, err = r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		if previous != "" {
+			pipe.Del(ctx, previous)
+		}
+		pipe.Set(ctx, key, user.ID, conf.TokenTTL)
+		pipe.Set(ctx, passwordResetUserKeyPrefix+userID, key, conf.TokenTTL)
+		return nil
+	})
+	if err != nil {
+		return r.span.Error(span, err.Error())
+	}
+
+	msg.Body = "请在 " + conf.TokenTTL.String() + " 内打开以下链接重置密码：" + conf.URL + resetToken
+	if err = r.notifier.Send(ctx, msg); err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	return nil
+
+}
+
+// ConfirmPasswordReset 使用重置 token 设置新密码。token 只能使用一次，
+// 重置成功后注销用户所有登录会话，并清空用户名的登录失败计数
+func (r *Repository) ConfirmPasswordReset(ctx context.Context, resetToken string, newPassword string) error {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	key := passwordResetKey(resetToken)
+	userID, err := r.redis.Get(ctx, key).Int64()
+	if errors.Is(err, redis.Nil) {
+		_ = r.span.Error(span, ErrInvalidResetToken.Error())
+		return ErrInvalidResetToken
+	}
+	if err != nil {
+		return r.span.Error(span, err.Error())
+	}
+
+	// 新密码不符合策略时保留 token，用户可以重新提交
+	user := &model.User{}
+	if result := r.UserModel().Select("username").Where("id = ?", userID).First(user); result.Error != nil {
+		return r.span.Error(span, result.Error.Error())
+	}
+	if err = r.policy.Validate(newPassword, user.Username); err != nil {
+		_ = r.span.Error(span, err.Error())
+		return err
+	}
+
+	// token 只能使用一次
+	n, err := r.redis.Del(ctx, key).Result()
+	if err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	if n == 0 {
+		_ = r.span.Error(span, ErrInvalidResetToken.Error())
+		return ErrInvalidResetToken
+	}
+	r.redis.Del(ctx, passwordResetUserKeyPrefix+strconv.FormatInt(userID, 10))
+
+	hash, err := r.hasher.Hash(newPassword)
+	if err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	result := r.UserModel().Where("id = ?", userID).Updates(map[string]any{
+		"password":    hash,
+		"update_time": time.Now().Unix(),
+	})
+	if result.Error != nil {
+		return r.span.Error(span, result.Error.Error())
+	}
+
+	if _, err = r.sessions.RevokeUser(ctx, userID); err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	if err = r.guard.Succeed(ctx, user.Username); err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	return nil
+
+}
@@ -6,16 +6,18 @@ import (
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
-	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"gorm.io/gorm"
 
+	"authservice/pkg/identity"
 	orderPBV1 "orderservice/genproto/go/v1"
 	productPBV1 "productservice/genproto/go/v1"
 	userPBV1 "userservice/genproto/go/v1"
+	"userservice/pkg/password"
+	"userservice/service/model"
 )
 
 // Server Server struct
@@ -56,6 +58,9 @@ func (s *Server) Register(ctx context.Context, req *userPBV1.RegisterRequest) (*
 		return nil, status.Error(codes.FailedPrecondition, "用户名已存在")
 	}
 	_, err = s.repo.Register(ctx, req)
+	if errors.Is(err, password.ErrPolicy) || errors.Is(err, ErrInvalidUsername) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -70,14 +75,32 @@ func (s *Server) Login(ctx context.Context, req *userPBV1.LoginRequest) (*userPB
 	loginResp := &userPBV1.LoginResponse{}
 
 	if err != nil {
+		switch {
+		case errors.Is(err, ErrAccountLocked):
+			clientIP, _ := s.repo.clientMetadata(ctx)
+			_ = level.Warn(s.logger).Log("msg", "用户登录失败次数过多，已锁定。用户名："+req.Username+"，IP："+clientIP)
+			return nil, status.Error(codes.ResourceExhausted, ErrTooManyAttempts.Error())
+		case errors.Is(err, ErrTooManyAttempts):
+			return nil, status.Error(codes.ResourceExhausted, ErrTooManyAttempts.Error())
+		case errors.Is(err, ErrUserDisabled):
+			return nil, status.Error(codes.PermissionDenied, ErrUserDisabled.Error())
+		}
 		_ = level.Error(s.logger).Log("msg", "用户登录失败，错误[1]："+err.Error())
 		return nil, status.Error(codes.FailedPrecondition, "账号或密码错误")
 	}
 
 	// 返回数据
+	if result.MfaToken != "" {
+		loginResp.MfaRequired = true
+		loginResp.MfaToken = result.MfaToken
+		loginResp.MfaExpireIn = result.MfaExpireAt
+	}
 	loginResp.AccessToken = result.AccessToken
-	loginResp.Username = req.Username
-	loginResp.ExpireIn = result.AccessTokenExpireTime
+	loginResp.Username = result.Username
+	loginResp.ExpireIn = result.AccessExpireAt
+	loginResp.RefreshToken = result.RefreshToken
+	loginResp.RefreshExpireIn = result.RefreshExpireAt
+	loginResp.TokenType = "Bearer"
 
 	resp := &userPBV1.Response{}
 	returnAnyData, err := anypb.New(loginResp)
@@ -90,15 +113,113 @@ func (s *Server) Login(ctx context.Context, req *userPBV1.LoginRequest) (*userPB
 	return resp, nil
 }
 
+// VerifyMfa 登录第二步。验证二次验证码后返回 access token
+func (s *Server) VerifyMfa(ctx context.Context, req *userPBV1.VerifyMfaRequest) (*userPBV1.Response, error) {
+
+	result, err := s.repo.VerifyMfa(ctx, req.MfaToken, req.Code)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrAccountLocked):
+			clientIP, _ := s.repo.clientMetadata(ctx)
+			_ = level.Warn(s.logger).Log("msg", "二次验证失败次数过多，已锁定。IP："+clientIP)
+			return nil, status.Error(codes.ResourceExhausted, ErrTooManyAttempts.Error())
+		case errors.Is(err, ErrInvalidMfaToken):
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case errors.Is(err, ErrInvalidMfaCode):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, ErrUserDisabled):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		_ = level.Error(s.logger).Log("msg", "二次验证失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "二次验证失败")
+	}
+
+	anyData, err := anypb.New(&userPBV1.LoginResponse{
+		Username:        result.Username,
+		AccessToken:     result.AccessToken,
+		ExpireIn:        result.AccessExpireAt,
+		RefreshToken:    result.RefreshToken,
+		RefreshExpireIn: result.RefreshExpireAt,
+		TokenType:       "Bearer",
+	})
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "二次验证失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// Refresh 使用 refresh token 换取新的 access token 以及 refresh token
+func (s *Server) Refresh(ctx context.Context, req *userPBV1.RefreshRequest) (*userPBV1.Response, error) {
+
+	result, err := s.repo.Refresh(ctx, req.RefreshToken)
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "刷新 access token 失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.Unauthenticated, "refresh token 无效")
+	}
+
+	anyData, err := anypb.New(&userPBV1.LoginResponse{
+		Username:        result.Username,
+		AccessToken:     result.AccessToken,
+		ExpireIn:        result.AccessExpireAt,
+		RefreshToken:    result.RefreshToken,
+		RefreshExpireIn: result.RefreshExpireAt,
+		TokenType:       "Bearer",
+	})
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "刷新 access token 失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// RequestPasswordReset 发送找回密码链接。无论账号是否存在都返回成功
+func (s *Server) RequestPasswordReset(ctx context.Context, req *userPBV1.RequestPasswordResetRequest) (*userPBV1.Response, error) {
+
+	if err := s.repo.RequestPasswordReset(ctx, req.Account); err != nil {
+		_ = level.Error(s.logger).Log("msg", "发送找回密码链接失败，错误[1]："+err.Error())
+	}
+	anyData, err := anypb.New(&userPBV1.UpdateResponse{Success: true})
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "发送找回密码链接失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// ConfirmPasswordReset 使用重置 token 设置新密码
+func (s *Server) ConfirmPasswordReset(ctx context.Context, req *userPBV1.ConfirmPasswordResetRequest) (*userPBV1.Response, error) {
+
+	if err := s.repo.ConfirmPasswordReset(ctx, req.Token, req.NewPassword); err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidResetToken):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, password.ErrPolicy):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		_ = level.Error(s.logger).Log("msg", "重置密码失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "重置密码失败")
+	}
+	anyData, err := anypb.New(&userPBV1.UpdateResponse{Success: true})
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "重置密码失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
 // Logout 用户退出登录
 func (s *Server) Logout(ctx context.Context, _ *emptypb.Empty) (*userPBV1.Response, error) {
 
-	accessToken, err := auth.AuthFromMD(ctx, "Bearer")
+	principal, err := principalFromContext(ctx)
 	if err != nil {
-		_ = level.Error(s.logger).Log("msg", "退出登录失败，错误[1]："+err.Error())
-		return nil, status.Error(codes.FailedPrecondition, "退出登录失败")
+		return nil, err
 	}
-	result, err := s.repo.Logout(ctx, accessToken)
+	result, err := s.repo.Logout(ctx, principal)
 	if err != nil {
 		return nil, err
 	}
@@ -109,12 +230,245 @@ func (s *Server) Logout(ctx context.Context, _ *emptypb.Empty) (*userPBV1.Respon
 
 }
 
+// ListSessions 获取当前用户的登录会话列表
+func (s *Server) ListSessions(ctx context.Context, _ *emptypb.Empty) (*userPBV1.Response, error) {
+
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.repo.ListSessions(ctx, principal.UserID)
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "获取登录会话失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "获取登录会话失败")
+	}
+
+	// 不返回 access token，只标记当前会话
+	listResp := &userPBV1.ListSessionsResponse{}
+	for _, v := range sessions {
+		listResp.List = append(listResp.List, &userPBV1.SessionDetail{
+			UserId:    v.UserID,
+			Username:  v.Username,
+			IssuedAt:  v.IssuedAt,
+			ExpireAt:  v.ExpireAt,
+			ClientIp:  v.ClientIP,
+			UserAgent: v.UserAgent,
+			Current:   v.ID == principal.SessionID,
+		})
+	}
+	anyData, err := anypb.New(listResp)
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "获取登录会话失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// RevokeSessions 注销当前用户的所有登录会话
+func (s *Server) RevokeSessions(ctx context.Context, _ *emptypb.Empty) (*userPBV1.Response, error) {
+
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := s.repo.RevokeSessions(ctx, principal.UserID)
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "注销登录会话失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "注销登录会话失败")
+	}
+	anyData, err := anypb.New(&userPBV1.RevokeSessionsResponse{Revoked: revoked})
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "注销登录会话失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// ListRoles 管理员获取角色列表
+func (s *Server) ListRoles(ctx context.Context, _ *emptypb.Empty) (*userPBV1.Response, error) {
+
+	roles, err := s.repo.ListRoles(ctx)
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "获取角色列表失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "获取角色列表失败")
+	}
+	listResp := &userPBV1.ListRolesResponse{}
+	for _, v := range roles {
+		listResp.List = append(listResp.List, roleDetail(v))
+	}
+	anyData, err := anypb.New(listResp)
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "获取角色列表失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// SaveRole 管理员添加或者更新角色
+func (s *Server) SaveRole(ctx context.Context, req *userPBV1.SaveRoleRequest) (*userPBV1.Response, error) {
+
+	role, err := s.repo.SaveRole(ctx, req)
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "保存角色失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "保存角色失败")
+	}
+	anyData, err := anypb.New(roleDetail(role))
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "保存角色失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// DeleteRole 管理员删除角色
+func (s *Server) DeleteRole(ctx context.Context, req *userPBV1.DeleteRoleRequest) (*userPBV1.Response, error) {
+
+	if err := s.repo.DeleteRole(ctx, req.Name); err != nil {
+		_ = level.Error(s.logger).Log("msg", "删除角色失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "删除角色失败")
+	}
+	return &userPBV1.Response{}, nil
+
+}
+
+// AssignRoles 管理员设置用户角色
+func (s *Server) AssignRoles(ctx context.Context, req *userPBV1.AssignRolesRequest) (*userPBV1.Response, error) {
+
+	if err := s.repo.AssignRoles(ctx, req.UserId, req.Roles); err != nil {
+		_ = level.Error(s.logger).Log("msg", "设置用户角色失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "设置用户角色失败")
+	}
+	return &userPBV1.Response{}, nil
+
+}
+
+// ListUsers 管理员分页查询用户
+func (s *Server) ListUsers(ctx context.Context, req *userPBV1.ListUsersRequest) (*userPBV1.Response, error) {
+
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	users, total, err := s.repo.ListUsers(ctx, req)
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "获取用户列表失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "获取用户列表失败")
+	}
+	listResp := &userPBV1.ListUsersResponse{Total: total}
+	for _, v := range users {
+		listResp.List = append(listResp.List, adminUserDetail(v, s.repo.Audience(ctx, principal, v.ID)))
+	}
+	anyData, err := anypb.New(listResp)
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "获取用户列表失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// GetUser 管理员获取用户详情
+func (s *Server) GetUser(ctx context.Context, req *userPBV1.GetUserRequest) (*userPBV1.Response, error) {
+
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.repo.GetUser(ctx, req.UserId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "用户不存在")
+		}
+		_ = level.Error(s.logger).Log("msg", "获取用户详情失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "获取用户详情失败")
+	}
+	anyData, err := anypb.New(adminUserDetail(user, s.repo.Audience(ctx, principal, user.ID)))
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "获取用户详情失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// SetUserStatus 管理员启用或者禁用用户
+func (s *Server) SetUserStatus(ctx context.Context, req *userPBV1.SetUserStatusRequest) (*userPBV1.Response, error) {
+
+	if err := s.repo.SetUserStatus(ctx, req.UserId, req.IsDisable); err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, status.Error(codes.NotFound, "用户不存在")
+		case errors.Is(err, ErrSelfOperation):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		_ = level.Error(s.logger).Log("msg", "设置用户状态失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "设置用户状态失败")
+	}
+	anyData, err := anypb.New(&userPBV1.UpdateResponse{Success: true})
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "设置用户状态失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// DeleteUser 管理员删除用户
+func (s *Server) DeleteUser(ctx context.Context, req *userPBV1.DeleteUserRequest) (*userPBV1.Response, error) {
+
+	if err := s.repo.DeleteUser(ctx, req.UserId); err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, status.Error(codes.NotFound, "用户不存在")
+		case errors.Is(err, ErrSelfOperation):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		_ = level.Error(s.logger).Log("msg", "删除用户失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "删除用户失败")
+	}
+	anyData, err := anypb.New(&userPBV1.UpdateResponse{Success: true})
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "删除用户失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// adminUserDetail 转换管理员查看的用户数据
+func adminUserDetail(user *AdminUser, audience Audience) *userPBV1.AdminUserDetail {
+	return &userPBV1.AdminUserDetail{
+		Info:       userDetail(user.User, audience),
+		Roles:      user.Roles,
+		MfaEnabled: user.MfaEnabled,
+	}
+}
+
+// roleDetail 转换角色数据
+func roleDetail(role *model.Role) *userPBV1.RoleDetail {
+	return &userPBV1.RoleDetail{
+		Id:          role.ID,
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: splitPermissions(role.Permissions),
+		CreateTime:  role.CreateTime,
+		UpdateTime:  role.UpdateTime,
+	}
+}
+
 // Info 获取用户信息
 func (s *Server) Info(ctx context.Context, _ *emptypb.Empty) (*userPBV1.Response, error) {
 
 	resp := &userPBV1.Response{}
-	accessToken, err := auth.AuthFromMD(ctx, "Bearer")
-	info, err := s.repo.Info(ctx, accessToken)
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info, err := s.repo.Info(ctx, principal.UserID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return resp, nil
@@ -122,22 +476,7 @@ func (s *Server) Info(ctx context.Context, _ *emptypb.Empty) (*userPBV1.Response
 		_ = level.Error(s.logger).Log("msg", "获取用户信息失败，错误[2]："+err.Error())
 		return nil, status.Error(codes.FailedPrecondition, "获取用户信息失败")
 	}
-	detail := &userPBV1.UserDetail_Detail{
-		Id:                    info.ID,
-		Username:              info.Username,
-		Sex:                   info.Sex,
-		IdNumber:              info.IDNumber,
-		Email:                 info.Email,
-		Phone:                 info.Phone,
-		IsDisable:             info.IsDisable,
-		AccessToken:           info.AccessToken,
-		AccessTokenExpireTime: info.AccessTokenExpireTime,
-		NickName:              info.NickName,
-		RealName:              info.RealName,
-		CreateTime:            info.CreateTime,
-		UpdateTime:            info.UpdateTime,
-	}
-	anyData, err := anypb.New(detail)
+	anyData, err := anypb.New(userDetail(info, s.repo.Audience(ctx, principal, principal.UserID)))
 	if err != nil {
 		_ = level.Error(s.logger).Log("msg", "获取用户详情失败，错误[3]："+err.Error())
 		return nil, status.Error(codes.FailedPrecondition, "获取详情失败")
@@ -147,34 +486,219 @@ func (s *Server) Info(ctx context.Context, _ *emptypb.Empty) (*userPBV1.Response
 
 }
 
-// OrderInfo 获取订单详情
+// Update 更新用户资料
+func (s *Server) Update(ctx context.Context, req *userPBV1.UpdateRequest) (*userPBV1.Response, error) {
+
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.repo.Update(ctx, principal.UserID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidUpdateMask), errors.Is(err, ErrInvalidUsername):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, ErrUsernameExists):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		_ = level.Error(s.logger).Log("msg", "更新用户资料失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "更新用户资料失败")
+	}
+	anyData, err := anypb.New(userDetail(user, s.repo.Audience(ctx, principal, principal.UserID)))
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "更新用户资料失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// ChangePassword 修改密码。修改成功后所有登录会话失效，需要重新登录
+func (s *Server) ChangePassword(ctx context.Context, req *userPBV1.ChangePasswordRequest) (*userPBV1.Response, error) {
+
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.repo.ChangePassword(ctx, principal.UserID, req); err != nil {
+		switch {
+		case errors.Is(err, ErrWrongPassword):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, password.ErrPolicy):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		_ = level.Error(s.logger).Log("msg", "修改密码失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "修改密码失败")
+	}
+	anyData, err := anypb.New(&userPBV1.UpdateResponse{Success: true})
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "修改密码失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// SendVerificationCode 发送邮箱或者手机号验证码
+func (s *Server) SendVerificationCode(ctx context.Context, req *userPBV1.SendVerificationCodeRequest) (*userPBV1.Response, error) {
+
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	expireAt, err := s.repo.SendVerificationCode(ctx, principal.UserID, req.Channel)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrCodeTooFrequent):
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		case errors.Is(err, ErrContactEmpty), errors.Is(err, ErrContactVerified):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		_ = level.Error(s.logger).Log("msg", "发送验证码失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "发送验证码失败")
+	}
+	anyData, err := anypb.New(&userPBV1.SendVerificationCodeResponse{ExpireAt: expireAt})
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "发送验证码失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// VerifyContact 使用验证码验证邮箱或者手机号
+func (s *Server) VerifyContact(ctx context.Context, req *userPBV1.VerifyContactRequest) (*userPBV1.Response, error) {
+
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.repo.VerifyContact(ctx, principal.UserID, req.Channel, req.Code); err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidCode), errors.Is(err, ErrContactTaken):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		_ = level.Error(s.logger).Log("msg", "验证失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "验证失败")
+	}
+	anyData, err := anypb.New(&userPBV1.UpdateResponse{Success: true})
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "验证失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// EnrollMfa 生成二次验证密钥。使用 ConfirmMfa 确认后生效
+func (s *Server) EnrollMfa(ctx context.Context, _ *emptypb.Empty) (*userPBV1.Response, error) {
+
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	secret, uri, err := s.repo.EnrollMfa(ctx, principal.UserID)
+	if err != nil {
+		if errors.Is(err, ErrMfaEnabled) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		_ = level.Error(s.logger).Log("msg", "生成二次验证密钥失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "生成二次验证密钥失败")
+	}
+	anyData, err := anypb.New(&userPBV1.EnrollMfaResponse{Secret: secret, Uri: uri})
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "生成二次验证密钥失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// ConfirmMfa 确认并开启二次验证。恢复码只在这里返回一次
+func (s *Server) ConfirmMfa(ctx context.Context, req *userPBV1.ConfirmMfaRequest) (*userPBV1.Response, error) {
+
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recoveryCodes, err := s.repo.ConfirmMfa(ctx, principal.UserID, req.Code)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrMfaEnabled), errors.Is(err, ErrMfaNotEnrolled), errors.Is(err, ErrInvalidMfaCode):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		_ = level.Error(s.logger).Log("msg", "开启二次验证失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "开启二次验证失败")
+	}
+	anyData, err := anypb.New(&userPBV1.ConfirmMfaResponse{RecoveryCodes: recoveryCodes})
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "开启二次验证失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// ResetMfa 管理员重置用户的二次验证
+func (s *Server) ResetMfa(ctx context.Context, req *userPBV1.ResetMfaRequest) (*userPBV1.Response, error) {
+
+	if err := s.repo.ResetMfa(ctx, req.UserId); err != nil {
+		_ = level.Error(s.logger).Log("msg", "重置二次验证失败，错误[1]："+err.Error())
+		return nil, status.Error(codes.FailedPrecondition, "重置二次验证失败")
+	}
+	anyData, err := anypb.New(&userPBV1.UpdateResponse{Success: true})
+	if err != nil {
+		_ = level.Error(s.logger).Log("msg", "重置二次验证失败，错误[2]："+err.Error())
+		return nil, status.Error(codes.Internal, "系统错误")
+	}
+	return &userPBV1.Response{ProtoAnyData: anyData}, nil
+
+}
+
+// OrderInfo 获取订单详情。商品以及用户数据根据订单获取，普通用户只能获取自己的订单
 func (s *Server) OrderInfo(ctx context.Context, request *userPBV1.OrderInfoRequest) (*userPBV1.Response, error) {
 
 	orderInfoResp := &userPBV1.OrderInfoResponse{}
 	resp := &userPBV1.Response{}
 
-	// 获取用户详情
-	userInfo, err := s.repo.UserInfo(ctx)
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 获取订单详情。orderservice 检查订单是否属于调用方
+	orderInfo, order, err := s.repo.OrderInfo(ctx, request)
 	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.NotFound, "订单不存在")
+		}
 		_ = level.Error(s.logger).Log("msg", "获取订单详情失败，错误[2]："+err.Error())
 		return nil, status.Error(codes.FailedPrecondition, "获取订单详情失败")
 	}
 
-	// 获取订单详情
-	orderInfo, err := s.repo.OrderInfo(ctx, request)
+	// 获取下单用户详情
+	user, err := s.repo.Info(ctx, order.UserId)
 	if err != nil {
 		_ = level.Error(s.logger).Log("msg", "获取订单详情失败，错误[3]："+err.Error())
 		return nil, status.Error(codes.FailedPrecondition, "获取订单详情失败")
 	}
 
-	// 获取产品详情
-	productInfo, err := s.repo.ProductInfo(ctx, request)
-	if err != nil {
-		_ = level.Error(s.logger).Log("msg", "获取订单详情失败，错误[4]："+err.Error())
-		return nil, status.Error(codes.FailedPrecondition, "获取订单详情失败")
+	// 获取订单商品的产品详情
+	for _, item := range order.Items {
+		productInfo, err := s.repo.ProductInfo(ctx, item.ProductId)
+		if err != nil {
+			_ = level.Error(s.logger).Log("msg", "获取订单详情失败，错误[4]："+err.Error())
+			return nil, status.Error(codes.FailedPrecondition, "获取订单详情失败")
+		}
+		anyProductData, err := anypb.New(productInfo)
+		if err != nil {
+			_ = level.Error(s.logger).Log("msg", "获取订单详情失败，错误[7]："+err.Error())
+			return nil, status.Error(codes.FailedPrecondition, "获取订单详情失败")
+		}
+		orderInfoResp.ProductList = append(orderInfoResp.ProductList, anyProductData)
 	}
 
-	anyUserData, err := anypb.New(userInfo)
+	anyUserData, err := anypb.New(userDetail(user, s.repo.Audience(ctx, principal, user.ID)))
 	if err != nil {
 		_ = level.Error(s.logger).Log("msg", "获取订单详情失败，错误[5]："+err.Error())
 		return nil, status.Error(codes.FailedPrecondition, "获取订单详情失败")
@@ -186,14 +710,7 @@ func (s *Server) OrderInfo(ctx context.Context, request *userPBV1.OrderInfoReque
 		return nil, status.Error(codes.FailedPrecondition, "获取订单详情失败")
 	}
 
-	anyProductData, err := anypb.New(productInfo)
-
-	if err != nil {
-		_ = level.Error(s.logger).Log("msg", "获取订单详情失败，错误[7]："+err.Error())
-		return nil, status.Error(codes.FailedPrecondition, "获取订单详情失败")
-	}
 	orderInfoResp.UserInfo = anyUserData
-	orderInfoResp.ProductInfo = anyProductData
 	orderInfoResp.OrderInfo = anyOrderData
 
 	// 组合所有数据
@@ -206,3 +723,14 @@ func (s *Server) OrderInfo(ctx context.Context, request *userPBV1.OrderInfoReque
 	return resp, nil
 
 }
+
+// principalFromContext 获取 authservice 传递的调用方身份
+func principalFromContext(ctx context.Context) (*identity.Principal, error) {
+
+	principal, ok := identity.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "未登录")
+	}
+	return principal, nil
+
+}
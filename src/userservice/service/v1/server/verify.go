@@ -0,0 +1,227 @@
+package serverV1
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"userservice/pkg/notify"
+	"userservice/service/model"
+)
+
+const (
+	// verifyCodeKeyPrefix 验证码 key 前缀。完整 key 为 verify_code:{channel}:{userId}
+	verifyCodeKeyPrefix = "verify_code:"
+	// verifyCodeSentKeyPrefix 发送间隔 key 前缀。key 存在期间不能再次发送
+	verifyCodeSentKeyPrefix = "verify_code_sent:"
+	// verifyCodeDailyKeyPrefix 每天发送次数 key 前缀
+	verifyCodeDailyKeyPrefix = "verify_code_daily:"
+)
+
+var (
+	// ErrCodeTooFrequent 验证码发送过于频繁
+	ErrCodeTooFrequent = errors.New("验证码发送过于频繁，请稍后再试")
+	// ErrContactEmpty 未设置邮箱或者手机号
+	ErrContactEmpty = errors.New("未设置邮箱或者手机号")
+	// ErrContactVerified 邮箱或者手机号已经验证
+	ErrContactVerified = errors.New("已经验证，无需重复验证")
+	// ErrContactTaken 邮箱或者手机号已经被其他账号验证
+	ErrContactTaken = errors.New("邮箱或者手机号已被其他账号使用")
+	// ErrInvalidCode 验证码错误或者已经过期
+	ErrInvalidCode = errors.New("验证码错误或者已过期")
+)
+
+// contactColumns 验证渠道对应的数据库字段
+var contactColumns = map[string][2]string{
+	notify.ChannelEmail: {"email", "email_verified"},
+	notify.ChannelPhone: {"phone", "phone_verified"},
+}
+
+// verifyCodeKey 验证码 key
+func verifyCodeKey(prefix string, channel string, userID int64) string {
+	return prefix + channel + ":" + strconv.FormatInt(userID, 10)
+}
+
+// hashCode 验证码只保存哈希值
+func hashCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// contact 获取用户在指定渠道的联系方式以及是否已经验证
+func contact(user *model.User, channel string) (string, bool) {
+
+	if channel == notify.ChannelEmail {
+		return user.Email, user.EmailVerified == model.Verified
+	}
+	return user.Phone, user.PhoneVerified == model.Verified
+
+}
+
+// SendVerificationCode 生成验证码并发送到用户当前的邮箱或者手机号。返回验证码过期时间
+func (r *Repository) SendVerificationCode(ctx context.Context, userID int64, channel string) (int64, error) {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	if _, ok := contactColumns[channel]; !ok {
+		return 0, r.span.Error(span, "不支持的验证渠道："+channel)
+	}
+	user := &model.User{}
+	if result := r.UserModel().Where("id = ?", userID).First(user); result.Error != nil {
+		return 0, r.span.Error(span, result.Error.Error())
+	}
+	target, verified := contact(user, channel)
+	if target == "" {
+		_ = r.span.Error(span, ErrContactEmpty.Error())
+		return 0, ErrContactEmpty
+	}
+	if verified {
+		_ = r.span.Error(span, ErrContactVerified.Error())
+		return 0, ErrContactVerified
+	}
+
+	// 发送频率限制
+	conf := r.conf.Verification
+	ok, err := r.redis.SetNX(ctx, verifyCodeKey(verifyCodeSentKeyPrefix, channel, userID), 1, conf.ResendInterval).Result()
+	if err != nil {
+		return 0, r.span.Error(span, err.Error())
+	}
+	if !ok {
+		_ = r.span.Error(span, ErrCodeTooFrequent.Error())
+		return 0, ErrCodeTooFrequent
+	}
+	if conf.DailyLimit > 0 {
+		dailyKey := verifyCodeKey(verifyCodeDailyKeyPrefix, channel, userID)
+		n, err := r.redis.Incr(ctx, dailyKey).Result()
+		if err != nil {
+			return 0, r.span.Error(span, err.Error())
+		}
+		if n == 1 {
+			r.redis.Expire(ctx, dailyKey, 24*time.Hour)
+		}
+		if n > conf.DailyLimit {
+			_ = r.span.Error(span, ErrCodeTooFrequent.Error())
+			return 0, ErrCodeTooFrequent
+		}
+	}
+
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return 0, r.span.Error(span, err.Error())
+	}
+	code := fmt.Sprintf("%06d", n.Int64())
+	key := verifyCodeKey(verifyCodeKeyPrefix, channel, userID)
+	_, err = r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key, "code", hashCode(code), "target", target, "attempts", 0)
+		pipe.Expire(ctx, key, conf.CodeTTL)
+		return nil
+	})
+	if err != nil {
+		return 0, r.span.Error(span, err.Error())
+	}
+
+	subject := "邮箱验证"
+	if channel == notify.ChannelPhone {
+		subject = "手机号验证"
+	}
+	if err = r.notifier.Send(ctx, &notify.Message{
+		Channel: channel,
+		To:      target,
+		Subject: subject,
+		Body:    "您的验证码是 " + code + "，" + conf.CodeTTL.String() + " 内有效。",
+	}); err != nil {
+		return 0, r.span.Error(span, err.Error())
+	}
+	return time.Now().Add(conf.CodeTTL).Unix(), nil
+
+}
+
+// VerifyContact 校验验证码并把邮箱或者手机号标记为已验证
+// 发送验证码之后修改过邮箱或者手机号时验证码失效
+func (r *Repository) VerifyContact(ctx context.Context, userID int64, channel string, code string) error {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	columns, ok := contactColumns[channel]
+	if !ok {
+		return r.span.Error(span, "不支持的验证渠道："+channel)
+	}
+	key := verifyCodeKey(verifyCodeKeyPrefix, channel, userID)
+	stored, err := r.redis.HGetAll(ctx, key).Result()
+	if err != nil {
+		return r.span.Error(span, err.Error())
+	}
+	if len(stored) == 0 {
+		_ = r.span.Error(span, ErrInvalidCode.Error())
+		return ErrInvalidCode
+	}
+
+	user := &model.User{}
+	if result := r.UserModel().Where("id = ?", userID).First(user); result.Error != nil {
+		return r.span.Error(span, result.Error.Error())
+	}
+	target, _ := contact(user, channel)
+	if target != stored["target"] {
+		r.redis.Del(ctx, key)
+		_ = r.span.Error(span, ErrInvalidCode.Error())
+		return ErrInvalidCode
+	}
+	if subtle.ConstantTimeCompare([]byte(hashCode(code)), []byte(stored["code"])) != 1 {
+		// 尝试次数过多时验证码失效
+		attempts, err := r.redis.HIncrBy(ctx, key, "attempts", 1).Result()
+		if err != nil {
+			return r.span.Error(span, err.Error())
+		}
+		if max := r.conf.Verification.MaxAttempts; max > 0 && attempts >= max {
+			r.redis.Del(ctx, key)
+		}
+		_ = r.span.Error(span, ErrInvalidCode.Error())
+		return ErrInvalidCode
+	}
+
+	// 同一个邮箱或者手机号只能被一个账号验证，并且不能与其他账号的用户名相同，用于登录时唯一确定账号
+	var count int64
+	result := r.UserModel().
+		Where(columns[0]+" = ? AND "+columns[1]+" = ? AND id <> ?", target, model.Verified, userID).
+		Count(&count)
+	if result.Error != nil {
+		return r.span.Error(span, result.Error.Error())
+	}
+	if count == 0 {
+		result = r.UnscopedUserModel().Where("username = ? AND id <> ?", target, userID).Count(&count)
+		if result.Error != nil {
+			return r.span.Error(span, result.Error.Error())
+		}
+	}
+	if count > 0 {
+		_ = r.span.Error(span, ErrContactTaken.Error())
+		return ErrContactTaken
+	}
+
+	result = r.UserModel().Where("id = ? AND "+columns[0]+" = ?", userID, target).Updates(map[string]any{
+		columns[1]:    model.Verified,
+		"update_time": time.Now().Unix(),
+	})
+	if result.Error != nil {
+		return r.span.Error(span, result.Error.Error())
+	}
+	if result.RowsAffected == 0 {
+		_ = r.span.Error(span, ErrInvalidCode.Error())
+		return ErrInvalidCode
+	}
+	r.redis.Del(ctx, key)
+	return nil
+
+}
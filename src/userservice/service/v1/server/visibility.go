@@ -0,0 +1,155 @@
+package serverV1
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"authservice/pkg/identity"
+	userPBV1 "userservice/genproto/go/v1"
+	"userservice/service/model"
+)
+
+// Audience 查看用户数据的调用方类型。数值越大可以看到的数据越多
+type Audience int
+
+const (
+	// AudiencePublic 查看其他用户数据的普通用户
+	AudiencePublic Audience = iota
+	// AudienceOwner 查看自己数据的用户
+	AudienceOwner
+	// AudienceOwnerElevated 拥有查看完整敏感字段权限，查看自己数据的用户
+	AudienceOwnerElevated
+	// AudienceAdmin 拥有查看任意用户完整敏感字段权限的管理员
+	AudienceAdmin
+)
+
+// fieldRule 敏感字段可见性规则
+type fieldRule struct {
+	// full 可以查看完整值的最低调用方类型
+	full Audience
+	// masked 可以查看脱敏值的最低调用方类型。低于该类型时不返回
+	masked Audience
+	// mask 脱敏方法
+	mask func(string) string
+	// field 规则作用的字段
+	field func(*userPBV1.UserDetail_Detail) *string
+}
+
+// sensitiveFields 用户敏感字段可见性规则。所有返回用户数据的地方都通过 userDetail 应用这些规则，
+// 新增敏感字段时只需要在这里声明
+var sensitiveFields = []fieldRule{
+	{
+		full:   AudienceOwnerElevated,
+		masked: AudienceOwner,
+		mask:   maskTail(4),
+		field:  func(d *userPBV1.UserDetail_Detail) *string { return &d.IdNumber },
+	},
+	{
+		full:   AudienceOwnerElevated,
+		masked: AudienceOwner,
+		mask:   maskName,
+		field:  func(d *userPBV1.UserDetail_Detail) *string { return &d.RealName },
+	},
+	{
+		full:   AudienceOwnerElevated,
+		masked: AudienceOwner,
+		mask:   maskEmail,
+		field:  func(d *userPBV1.UserDetail_Detail) *string { return &d.Email },
+	},
+	{
+		full:   AudienceOwnerElevated,
+		masked: AudienceOwner,
+		mask:   maskTail(4),
+		field:  func(d *userPBV1.UserDetail_Detail) *string { return &d.Phone },
+	},
+}
+
+// userDetail 转换用户数据，并按照调用方类型对敏感字段脱敏
+func userDetail(user *model.User, audience Audience) *userPBV1.UserDetail_Detail {
+
+	detail := &userPBV1.UserDetail_Detail{
+		Id:            user.ID,
+		Username:      user.Username,
+		Sex:           user.Sex,
+		IdNumber:      user.IDNumber,
+		Email:         user.Email,
+		Phone:         user.Phone,
+		IsDisable:     user.IsDisable,
+		NickName:      user.NickName,
+		RealName:      user.RealName,
+		CreateTime:    user.CreateTime,
+		UpdateTime:    user.UpdateTime,
+		EmailVerified: user.EmailVerified,
+		PhoneVerified: user.PhoneVerified,
+	}
+	for _, rule := range sensitiveFields {
+		value := rule.field(detail)
+		switch {
+		case audience >= rule.full:
+		case audience >= rule.masked && *value != "":
+			*value = rule.mask(*value)
+		default:
+			*value = ""
+		}
+	}
+	return detail
+
+}
+
+// Audience 获取调用方查看 userID 用户数据时的类型。权限查询失败时按照较低的类型处理
+func (r *Repository) Audience(ctx context.Context, principal *identity.Principal, userID int64) Audience {
+
+	ctx, span := r.span.Record(ctx, r.conf.Trace.TracerName)
+	defer span.End()
+
+	ok, err := r.rbac.HasPermission(ctx, principal.Roles, r.conf.Visibility.AdminPermission)
+	if err != nil {
+		_ = r.span.Error(span, err.Error())
+	}
+	if ok {
+		return AudienceAdmin
+	}
+	if principal.UserID != userID {
+		return AudiencePublic
+	}
+	ok, err = r.rbac.HasPermission(ctx, principal.Roles, r.conf.Visibility.ElevatedPermission)
+	if err != nil {
+		_ = r.span.Error(span, err.Error())
+	}
+	if ok {
+		return AudienceOwnerElevated
+	}
+	return AudienceOwner
+
+}
+
+// maskTail 只保留最后 keep 个字符
+func maskTail(keep int) func(string) string {
+	return func(value string) string {
+		runes := []rune(value)
+		if len(runes) <= keep {
+			return strings.Repeat("*", len(runes))
+		}
+		return strings.Repeat("*", len(runes)-keep) + string(runes[len(runes)-keep:])
+	}
+}
+
+// maskName 只保留第一个字符
+func maskName(value string) string {
+
+	_, size := utf8.DecodeRuneInString(value)
+	return value[:size] + strings.Repeat("*", utf8.RuneCountInString(value[size:]))
+
+}
+
+// maskEmail 只保留用户名的第一个字符以及域名
+func maskEmail(value string) string {
+
+	at := strings.LastIndex(value, "@")
+	if at <= 0 {
+		return maskTail(0)(value)
+	}
+	return maskName(value[:at]) + value[at:]
+
+}